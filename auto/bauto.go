@@ -0,0 +1,552 @@
+package bauto
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	bstd "github.com/deneonet/benc/std"
+)
+
+var ErrUnsupportedType = errors.New("unsupported type")
+var ErrInvalidUnmarshal = errors.New("unmarshal target must be a non-nil pointer")
+
+// A codec sizes, marshals and unmarshals the values of one Go type, using the bstd functions.
+type codec struct {
+	size      func(v reflect.Value) int
+	marshal   func(n int, b []byte, v reflect.Value) int
+	unmarshal func(n int, b []byte, v reflect.Value) (int, error)
+}
+
+// The type attributes of a field, set by the `benc:"..."` tag.
+type attrs struct {
+	unsafe bool
+	rcopy  bool
+}
+
+// Codecs of all types, that were marshalled or unmarshalled before.
+var codecs sync.Map
+
+// Returns the cached codec for 't', or builds and caches it.
+func codecOf(t reflect.Type) (*codec, error) {
+	if c, ok := codecs.Load(t); ok {
+		return c.(*codec), nil
+	}
+
+	building := make(map[reflect.Type]*codec)
+	c, err := buildCodec(t, attrs{}, building)
+	if err != nil {
+		return nil, err
+	}
+
+	for bt, bc := range building {
+		codecs.LoadOrStore(bt, bc)
+	}
+	c2, _ := codecs.LoadOrStore(t, c)
+	return c2.(*codec), nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Builds the codec for 't'. Structs are added to 'building' before their fields are built, so recursive types work.
+func buildCodec(t reflect.Type, a attrs, building map[reflect.Type]*codec) (*codec, error) {
+	if t == timeType {
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeTime() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalTime(n, b, v.Interface().(time.Time))
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalTime(n, b)
+				v.Set(reflect.ValueOf(t))
+				return n, err
+			},
+		}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeBool() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalBool(n, b, v.Bool())
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalBool(n, b)
+				v.SetBool(t)
+				return n, err
+			},
+		}, nil
+	case reflect.Uint8:
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeByte() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalByte(n, b, byte(v.Uint()))
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalByte(n, b)
+				v.SetUint(uint64(t))
+				return n, err
+			},
+		}, nil
+	case reflect.Int:
+		return &codec{
+			size: func(v reflect.Value) int { return bstd.SizeInt(int(v.Int())) },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalInt(n, b, int(v.Int()))
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalInt(n, b)
+				v.SetInt(int64(t))
+				return n, err
+			},
+		}, nil
+	case reflect.Int16:
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeInt16() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalInt16(n, b, int16(v.Int()))
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalInt16(n, b)
+				v.SetInt(int64(t))
+				return n, err
+			},
+		}, nil
+	case reflect.Int32:
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeInt32() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalInt32(n, b, int32(v.Int()))
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalInt32(n, b)
+				v.SetInt(int64(t))
+				return n, err
+			},
+		}, nil
+	case reflect.Int64:
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeInt64() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalInt64(n, b, v.Int())
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalInt64(n, b)
+				v.SetInt(t)
+				return n, err
+			},
+		}, nil
+	case reflect.Uint:
+		return &codec{
+			size: func(v reflect.Value) int { return bstd.SizeUint(uint(v.Uint())) },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalUint(n, b, uint(v.Uint()))
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalUint(n, b)
+				v.SetUint(uint64(t))
+				return n, err
+			},
+		}, nil
+	case reflect.Uint16:
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeUint16() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalUint16(n, b, uint16(v.Uint()))
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalUint16(n, b)
+				v.SetUint(uint64(t))
+				return n, err
+			},
+		}, nil
+	case reflect.Uint32:
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeUint32() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalUint32(n, b, uint32(v.Uint()))
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalUint32(n, b)
+				v.SetUint(uint64(t))
+				return n, err
+			},
+		}, nil
+	case reflect.Uint64:
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeUint64() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalUint64(n, b, v.Uint())
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalUint64(n, b)
+				v.SetUint(t)
+				return n, err
+			},
+		}, nil
+	case reflect.Float32:
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeFloat32() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalFloat32(n, b, float32(v.Float()))
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalFloat32(n, b)
+				v.SetFloat(float64(t))
+				return n, err
+			},
+		}, nil
+	case reflect.Float64:
+		return &codec{
+			size: func(reflect.Value) int { return bstd.SizeFloat64() },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalFloat64(n, b, v.Float())
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := bstd.UnmarshalFloat64(n, b)
+				v.SetFloat(t)
+				return n, err
+			},
+		}, nil
+	case reflect.String:
+		unmarshal := bstd.UnmarshalString
+		if a.unsafe {
+			unmarshal = bstd.UnmarshalUnsafeString
+		}
+
+		return &codec{
+			size: func(v reflect.Value) int { return bstd.SizeString(v.String()) },
+			marshal: func(n int, b []byte, v reflect.Value) int {
+				return bstd.MarshalString(n, b, v.String())
+			},
+			unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+				n, t, err := unmarshal(n, b)
+				v.SetString(t)
+				return n, err
+			},
+		}, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return bytesCodec(a), nil
+		}
+
+		elem, err := buildCodec(t.Elem(), a, building)
+		if err != nil {
+			return nil, err
+		}
+		return sliceCodec(t, elem), nil
+	case reflect.Map:
+		key, err := buildCodec(t.Key(), a, building)
+		if err != nil {
+			return nil, err
+		}
+		elem, err := buildCodec(t.Elem(), a, building)
+		if err != nil {
+			return nil, err
+		}
+		return mapCodec(t, key, elem), nil
+	case reflect.Struct:
+		return structCodec(t, building)
+	default:
+		return nil, fmt.Errorf("bauto: %w: %s", ErrUnsupportedType, t)
+	}
+}
+
+func bytesCodec(a attrs) *codec {
+	unmarshal := bstd.UnmarshalBytesCropped
+	if a.rcopy {
+		unmarshal = bstd.UnmarshalBytesCopied
+	}
+
+	return &codec{
+		size: func(v reflect.Value) int { return bstd.SizeBytes(v.Bytes()) },
+		marshal: func(n int, b []byte, v reflect.Value) int {
+			return bstd.MarshalBytes(n, b, v.Bytes())
+		},
+		unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+			n, t, err := unmarshal(n, b)
+			v.SetBytes(t)
+			return n, err
+		},
+	}
+}
+
+// Returns 'l' zero-sized placeholders, so the generic bstd slice functions can walk a reflected slice or map.
+// No memory is allocated for them.
+func placeholders(l int) []struct{} {
+	return make([]struct{}, l)
+}
+
+func sliceCodec(t reflect.Type, elem *codec) *codec {
+	return &codec{
+		size: func(v reflect.Value) int {
+			i := 0
+			return bstd.SizeSlice(placeholders(v.Len()), func(struct{}) int {
+				s := elem.size(v.Index(i))
+				i++
+				return s
+			})
+		},
+		marshal: func(n int, b []byte, v reflect.Value) int {
+			i := 0
+			return bstd.MarshalSlice(n, b, placeholders(v.Len()), func(n int, b []byte, _ struct{}) int {
+				n = elem.marshal(n, b, v.Index(i))
+				i++
+				return n
+			})
+		},
+		unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+			slice := reflect.MakeSlice(t, 0, 0)
+			n, _, err := bstd.UnmarshalSliceInto(n, b, nil, func(n int, b []byte, _ *struct{}) (int, error) {
+				e := reflect.New(t.Elem()).Elem()
+				n, err := elem.unmarshal(n, b, e)
+				if err != nil {
+					return 0, err
+				}
+				slice = reflect.Append(slice, e)
+				return n, nil
+			})
+			if err != nil {
+				return 0, err
+			}
+			v.Set(slice)
+			return n, nil
+		},
+	}
+}
+
+var boolType = reflect.TypeOf(false)
+var boolSliceType = reflect.TypeOf([]bool(nil))
+
+// A bool slice field is packed, 8 bools per byte, see bstd.MarshalBoolSlice.
+func boolSliceCodec(t reflect.Type) *codec {
+	return &codec{
+		size: func(v reflect.Value) int {
+			return bstd.SizeBoolSlice(v.Convert(boolSliceType).Interface().([]bool))
+		},
+		marshal: func(n int, b []byte, v reflect.Value) int {
+			return bstd.MarshalBoolSlice(n, b, v.Convert(boolSliceType).Interface().([]bool))
+		},
+		unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+			n, ts, err := bstd.UnmarshalBoolSlice(n, b)
+			if err != nil {
+				return 0, err
+			}
+			v.Set(reflect.ValueOf(ts).Convert(t))
+			return n, nil
+		},
+	}
+}
+
+// A map is marshalled the same way as a slice of its entries, so the bstd slice functions are used for maps too.
+func mapCodec(t reflect.Type, key *codec, elem *codec) *codec {
+	return &codec{
+		size: func(v reflect.Value) int {
+			iter := v.MapRange()
+			return bstd.SizeSlice(placeholders(v.Len()), func(struct{}) int {
+				iter.Next()
+				return key.size(iter.Key()) + elem.size(iter.Value())
+			})
+		},
+		marshal: func(n int, b []byte, v reflect.Value) int {
+			iter := v.MapRange()
+			return bstd.MarshalSlice(n, b, placeholders(v.Len()), func(n int, b []byte, _ struct{}) int {
+				iter.Next()
+				n = key.marshal(n, b, iter.Key())
+				return elem.marshal(n, b, iter.Value())
+			})
+		},
+		unmarshal: func(n int, b []byte, v reflect.Value) (int, error) {
+			m := reflect.MakeMap(t)
+			n, _, err := bstd.UnmarshalSliceInto(n, b, nil, func(n int, b []byte, _ *struct{}) (int, error) {
+				k := reflect.New(t.Key()).Elem()
+				e := reflect.New(t.Elem()).Elem()
+
+				n, err := key.unmarshal(n, b, k)
+				if err != nil {
+					return 0, err
+				}
+				n, err = elem.unmarshal(n, b, e)
+				if err != nil {
+					return 0, err
+				}
+				m.SetMapIndex(k, e)
+				return n, nil
+			})
+			if err != nil {
+				return 0, err
+			}
+			v.Set(m)
+			return n, nil
+		},
+	}
+}
+
+type structField struct {
+	index int
+	codec *codec
+}
+
+// Parses the `benc:"..."` tag of a struct field.
+// Returns false, if the field is skipped.
+func parseTag(f reflect.StructField) (attrs, bool, error) {
+	var a attrs
+
+	tag, ok := f.Tag.Lookup("benc")
+	if !ok || tag == "" {
+		return a, true, nil
+	}
+	if tag == "-" {
+		return a, false, nil
+	}
+
+	for _, opt := range strings.Split(tag, ",") {
+		switch strings.TrimSpace(opt) {
+		case "unsafe":
+			a.unsafe = true
+		case "rcopy":
+			a.rcopy = true
+		default:
+			return a, false, fmt.Errorf("bauto: unknown option %q in the benc tag of field %s", opt, f.Name)
+		}
+	}
+	return a, true, nil
+}
+
+// Builds the codec for a struct, the fields are marshalled in declaration order, like `MarshalPlain` of generated containers.
+// Unexported fields and fields tagged with `benc:"-"` are skipped.
+func structCodec(t reflect.Type, building map[reflect.Type]*codec) (*codec, error) {
+	if c, ok := building[t]; ok {
+		return c, nil
+	}
+	if c, ok := codecs.Load(t); ok {
+		return c.(*codec), nil
+	}
+
+	c := &codec{}
+	building[t] = c
+
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		a, ok, err := parseTag(f)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		// Packed, like the []bool fields of generated containers, bool slices in other slices or maps are not
+		if f.Type.Kind() == reflect.Slice && f.Type.Elem() == boolType {
+			fields = append(fields, structField{index: i, codec: boolSliceCodec(f.Type)})
+			continue
+		}
+
+		fc, err := buildCodec(f.Type, a, building)
+		if err != nil {
+			return nil, fmt.Errorf("field %s of %s: %w", f.Name, t, err)
+		}
+		fields = append(fields, structField{index: i, codec: fc})
+	}
+
+	c.size = func(v reflect.Value) (s int) {
+		for _, f := range fields {
+			s += f.codec.size(v.Field(f.index))
+		}
+		return
+	}
+	c.marshal = func(n int, b []byte, v reflect.Value) int {
+		for _, f := range fields {
+			n = f.codec.marshal(n, b, v.Field(f.index))
+		}
+		return n
+	}
+	c.unmarshal = func(n int, b []byte, v reflect.Value) (int, error) {
+		var err error
+		for _, f := range fields {
+			if n, err = f.codec.unmarshal(n, b, v.Field(f.index)); err != nil {
+				return 0, err
+			}
+		}
+		return n, nil
+	}
+	return c, nil
+}
+
+// Returns the value 'v' points to, if 'v' is a pointer.
+func indirect(v any) reflect.Value {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		return rv.Elem()
+	}
+	return rv
+}
+
+// Returns the bytes needed to marshal 'v'.
+//
+// Possible errors returned:
+//   - ErrUnsupportedType        - 'v' is, or contains, a type that benc doesn't support.
+func Size(v any) (int, error) {
+	rv := indirect(v)
+	if !rv.IsValid() {
+		return 0, fmt.Errorf("bauto: %w: nil", ErrUnsupportedType)
+	}
+
+	c, err := codecOf(rv.Type())
+	if err != nil {
+		return 0, err
+	}
+	return c.size(rv), nil
+}
+
+// Marshals 'v', a struct or a pointer to a struct, into a new buffer.
+// The bytes are the same as the ones produced by `MarshalPlain` of a generated container with the same fields.
+//
+// Possible errors returned:
+//   - ErrUnsupportedType        - 'v' is, or contains, a type that benc doesn't support.
+func Marshal(v any) ([]byte, error) {
+	rv := indirect(v)
+	if !rv.IsValid() {
+		return nil, fmt.Errorf("bauto: %w: nil", ErrUnsupportedType)
+	}
+
+	c, err := codecOf(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, c.size(rv))
+	c.marshal(0, b, rv)
+	return b, nil
+}
+
+// Unmarshals 'b' into the value 'v' points to.
+//
+// Possible errors returned:
+//   - ErrInvalidUnmarshal       - 'v' is not a non-nil pointer.
+//   - ErrUnsupportedType        - 'v' points to, or contains, a type that benc doesn't support.
+//   - any error returned by the bstd `Unmarshal...` functions.
+func Unmarshal(b []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return ErrInvalidUnmarshal
+	}
+	rv = rv.Elem()
+
+	c, err := codecOf(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	_, err = c.unmarshal(0, b, rv)
+	return err
+}
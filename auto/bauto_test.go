@@ -0,0 +1,199 @@
+package bauto
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/deneonet/benc/testing/complex_data"
+	"github.com/deneonet/benc/testing/others"
+	"github.com/deneonet/benc/testing/person"
+)
+
+func TestMatchesMarshalPlain(t *testing.T) {
+	complexData := complex_data.ComplexData{
+		Id:    12345,
+		Title: "Example Complex Data",
+		Items: []complex_data.SubItem{
+			{
+				Sub_id:      1,
+				Description: "SubItem 1",
+				Sub_items: []complex_data.SubSubItem{
+					{
+						Sub_sub_id:   "subsub1",
+						Sub_sub_data: []byte{0x01, 0x02, 0x03},
+					},
+				},
+			},
+		},
+		Metadata: map[string]int32{"key1": 10},
+		Sub_data: complex_data.SubComplexData{
+			Sub_id:          999,
+			Sub_title:       "Sub Complex Data",
+			Sub_binary_data: [][]byte{{0x11, 0x22, 0x33}, {0x44, 0x55, 0x66}},
+			Sub_items:       []complex_data.SubItem{},
+			Sub_metadata:    map[string]string{"meta1": "value1"},
+		},
+		Large_binary_data: [][]byte{{0xFF, 0xEE, 0xDD}},
+		Huge_list:         []int64{1000000, 2000000, 3000000},
+	}
+
+	othersTest := others.OthersTest{
+		Ui:           1 << 30,
+		Ui64:         64,
+		Ui64Arr:      []uint64{1, 2, 3},
+		Ui64Map:      map[uint64]uint32{1: 2},
+		Ui32:         32,
+		Ui16:         16,
+		ExampleEnum:  others.ExampleEnumThree,
+		ExampleEnum2: others.ExampleEnum2Six,
+		Person: person.Person{
+			Age:     24,
+			Name:    "Johnny",
+			Parents: person.Parents{Mother: "Johna", Father: "John"},
+			Child:   person.Child{Name: "Johnny Jr.", Age: 3},
+		},
+		Person2: [][][]person.Person2{{{{Age: 10, Name: "Person2"}}}, {}},
+		BankMap: map[others.Bank]others.Citizen{{Name: "Bank"}: {Name: "Citizen"}},
+	}
+
+	timeTest := others.TimeTest{
+		CreatedAt: time.Date(2024, 5, 17, 13, 37, 0, 123456789, time.UTC),
+		Ttl:       90 * time.Minute,
+		History:   []time.Time{{}},
+		Timeouts:  map[string]time.Duration{"read": -5 * time.Second},
+	}
+
+	flagsTest := others.FlagsTest{
+		Flags:      []bool{true, false, true, true, false, false, false, false, true},
+		FlagGroups: [][]bool{{true, false}, {}},
+		Name:       "flags",
+	}
+
+	tests := []struct {
+		name  string
+		v     any
+		ret   any
+		plain func() []byte
+	}{
+		{"complex data", &complexData, &complex_data.ComplexData{}, func() []byte {
+			b := make([]byte, complexData.SizePlain())
+			complexData.MarshalPlain(0, b)
+			return b
+		}},
+		{"others", othersTest, &others.OthersTest{}, func() []byte {
+			b := make([]byte, othersTest.SizePlain())
+			othersTest.MarshalPlain(0, b)
+			return b
+		}},
+		{"time", &timeTest, &others.TimeTest{}, func() []byte {
+			b := make([]byte, timeTest.SizePlain())
+			timeTest.MarshalPlain(0, b)
+			return b
+		}},
+		{"packed bools", &flagsTest, &others.FlagsTest{}, func() []byte {
+			b := make([]byte, flagsTest.SizePlain())
+			flagsTest.MarshalPlain(0, b)
+			return b
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := tt.plain()
+
+			s, err := Size(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s != len(expected) {
+				t.Fatalf("size: expected %d, got %d", len(expected), s)
+			}
+
+			b, err := Marshal(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(b, expected) {
+				t.Fatalf("no match\nauto:  %v\nplain: %v", b, expected)
+			}
+
+			if err = Unmarshal(b, tt.ret); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(reflect.ValueOf(tt.ret).Elem().Interface(), reflect.Indirect(reflect.ValueOf(tt.v)).Interface()) {
+				t.Fatalf("no match\norg: %v\ndec: %v", tt.v, tt.ret)
+			}
+		})
+	}
+}
+
+type Tree struct {
+	Name     string `benc:"unsafe"`
+	Data     []byte `benc:"rcopy"`
+	Children []Tree
+	Cache    map[string]int `benc:"-"`
+	secret   int
+}
+
+func TestTagsAndRecursiveTypes(t *testing.T) {
+	tree := Tree{
+		Name:     "root",
+		Data:     []byte{1, 2, 3},
+		Children: []Tree{{Name: "leaf", Data: []byte{}, Children: []Tree{}}},
+		Cache:    map[string]int{"skipped": 1},
+		secret:   1,
+	}
+
+	b, err := Marshal(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var retTree Tree
+	if err = Unmarshal(b, &retTree); err != nil {
+		t.Fatal(err)
+	}
+
+	tree.Cache = nil
+	tree.secret = 0
+	if !reflect.DeepEqual(retTree, tree) {
+		t.Fatalf("no match\norg: %v\ndec: %v", tree, retTree)
+	}
+
+	for i := range b {
+		b[i] = 0xFF
+	}
+	if !bytes.Equal(retTree.Data, []byte{1, 2, 3}) {
+		t.Fatal("rcopy: expected the byte slice to be copied")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	type unsupported struct {
+		P *int
+	}
+	type badTag struct {
+		S string `benc:"unknown"`
+	}
+
+	if _, err := Marshal(unsupported{}); !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("expected ErrUnsupportedType, got %v", err)
+	}
+	if _, err := Marshal(badTag{}); err == nil {
+		t.Fatal("expected an error for an unknown tag option")
+	}
+	if _, err := Marshal(nil); !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("expected ErrUnsupportedType, got %v", err)
+	}
+
+	var tree Tree
+	if err := Unmarshal([]byte{}, tree); err != ErrInvalidUnmarshal {
+		t.Fatalf("expected ErrInvalidUnmarshal, got %v", err)
+	}
+	if err := Unmarshal([]byte{}, &tree); err == nil {
+		t.Fatal("expected an error for an empty buffer")
+	}
+}
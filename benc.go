@@ -1,16 +1,81 @@
 package benc
 
 import (
+	"cmp"
 	"errors"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 var ErrBufTooSmall = errors.New("buffer too small")
 var ErrReuseBufTooSmall = errors.New("reuse buffer too small")
 var ErrOverflow = errors.New("varint overflows a 64-bit integer")
+var ErrLimitExceeded = errors.New("decode limit exceeded")
 var ErrVerifyUnmarshal = errors.New("check for a mistake in the unmarshal process")
 var ErrVerifyMarshal = errors.New("check for a mistake in calculating the size or in the marshal process")
 
+// DecodeError describes, where and why unmarshalling failed.
+// It matches its cause with errors.Is, for example `errors.Is(err, benc.ErrBufTooSmall)`.
+type DecodeError struct {
+	// Offset of the value, that failed to unmarshal, -1 if unknown.
+	Offset int
+	// Expected type of the value, for example "uint64" or "string", empty if unknown.
+	Type string
+	// Containers and fields, from the outermost one, the value belongs to, for example
+	// "Person.Parents(3)", "Parent.Name(1)". Empty, if it wasn't unmarshalled by generated code.
+	Path []string
+	// The cause, for example ErrBufTooSmall.
+	Err error
+}
+
+// Returns a *DecodeError, that the value of type 'typ' at 'offset' failed to unmarshal, because of 'err'.
+// If 'err' already is a *DecodeError, it is returned unchanged, as it is more precise.
+func NewDecodeError(offset int, typ string, err error) error {
+	var de *DecodeError
+	if errors.As(err, &de) {
+		return err
+	}
+	return &DecodeError{Offset: offset, Type: typ, Err: err}
+}
+
+// Prepends 'elem' to the path of 'err'. If 'err' isn't a *DecodeError, it gets wrapped in one.
+func WithDecodePath(err error, elem string) error {
+	var de *DecodeError
+	if !errors.As(err, &de) {
+		de = &DecodeError{Offset: -1, Err: err}
+		err = de
+	}
+	de.Path = append([]string{elem}, de.Path...)
+	return err
+}
+
+func (e *DecodeError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("benc: decode")
+	if len(e.Path) > 0 {
+		sb.WriteString(" ")
+		sb.WriteString(strings.Join(e.Path, " -> "))
+	}
+	if e.Type != "" {
+		sb.WriteString(" ")
+		sb.WriteString(e.Type)
+	}
+	if e.Offset >= 0 {
+		sb.WriteString(" at offset ")
+		sb.WriteString(strconv.Itoa(e.Offset))
+	}
+	sb.WriteString(": ")
+	sb.WriteString(e.Err.Error())
+	return sb.String()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
 const (
 	Bytes2 int = 2
 	Bytes4 int = 4
@@ -20,63 +85,248 @@ const (
 type optFunc func(*Opts)
 
 type Opts struct {
-	bufSize uint
+	classes []uint
+	grow    bool
 }
 
 func defaultOpts() Opts {
 	return Opts{
-		bufSize: 1024,
+		classes: []uint{1024},
 	}
 }
 
+// BufPool pools buffers in size classes, lease them with Get.
+// A lease gets a buffer of the smallest class, that fits.
+//
+// It is safe for concurrent use.
 type BufPool struct {
+	// The size of the largest class.
 	BufSize uint
-	p       sync.Pool
+
+	classes []*sizeClass
+	grow    bool
+
+	gets     atomic.Uint64
+	misses   atomic.Uint64
+	oversize atomic.Uint64
 }
 
+type sizeClass struct {
+	size uint
+	p    sync.Pool
+}
+
+// BufPoolStats counts the leases of a BufPool, to tune its size classes.
+type BufPoolStats struct {
+	// Leases, that got a pooled buffer.
+	Hits uint64
+	// Leases, that allocated a new buffer for their size class.
+	Misses uint64
+	// Leases larger than the largest size class.
+	Oversize uint64
+}
+
+// Lease is a buffer borrowed from a BufPool, it is owned by the caller until it is released.
+// Only the buffers are pooled, every Get returns a new lease.
+type Lease struct {
+	c   *sizeClass
+	buf *[]byte
+	b   []byte
+}
+
+// Pools buffers of 'bufSize' bytes only, larger leases return ErrReuseBufTooSmall. The default is 1024 bytes.
 func WithBufferSize(bufSize uint) optFunc {
 	return func(o *Opts) {
-		o.bufSize = bufSize
+		o.classes = []uint{bufSize}
+		o.grow = false
+	}
+}
+
+// Pools buffers of the sizes 'sizes', larger leases get a buffer allocated, that isn't pooled.
+func WithSizeClasses(sizes ...uint) optFunc {
+	return func(o *Opts) {
+		o.classes = slices.Clone(sizes)
+		o.grow = true
 	}
 }
 
+// Pools buffers of every power of two from 'minSize' up to 'maxSize' bytes, see WithSizeClasses.
+func WithPowerOfTwoClasses(minSize uint, maxSize uint) optFunc {
+	return func(o *Opts) {
+		o.classes = nil
+		for s := uint(1); s <= maxSize && s != 0; s <<= 1 {
+			if s >= minSize {
+				o.classes = append(o.classes, s)
+			}
+		}
+		o.grow = true
+	}
+}
+
+// Returns a new buf pool.
+//
+// !- Panics, if there are no size classes.
 func NewBufPool(opts ...optFunc) *BufPool {
 	o := defaultOpts()
 	for _, fn := range opts {
 		fn(&o)
 	}
 
+	slices.Sort(o.classes)
+	o.classes = slices.Compact(o.classes)
+	if len(o.classes) == 0 {
+		panic("benc: buf pool without size classes")
+	}
+
 	bp := &BufPool{
-		BufSize: o.bufSize,
-		p: sync.Pool{
-			New: func() interface{} {
-				s := make([]byte, o.bufSize)
-				return &s
-			},
-		},
+		BufSize: o.classes[len(o.classes)-1],
+		grow:    o.grow,
+	}
+	for _, size := range o.classes {
+		c := &sizeClass{size: size}
+		c.p.New = func() interface{} {
+			bp.misses.Add(1)
+			buf := make([]byte, size)
+			return &buf
+		}
+		bp.classes = append(bp.classes, c)
 	}
 	return bp
 }
 
+// Leases a buffer of 's' bytes, marshal into Bytes and call Release, once the bytes are not used anymore.
+// No other caller gets the buffer, until it is released.
+//
+// s = size of the data in bytes, retrieved by using the benc `Size...` methods
+//
+// Possible errors returned:
+//   - ErrReuseBufTooSmall       - 's' is larger than the largest size class and the pool doesn't grow, see WithBufferSize.
+func (bp *BufPool) Get(s int) (*Lease, error) {
+	if s < 0 {
+		return nil, ErrReuseBufTooSmall
+	}
+
+	i, _ := slices.BinarySearchFunc(bp.classes, uint(s), func(c *sizeClass, s uint) int {
+		return cmp.Compare(c.size, s)
+	})
+	if i == len(bp.classes) {
+		bp.oversize.Add(1)
+		if !bp.grow {
+			return nil, ErrReuseBufTooSmall
+		}
+		return &Lease{b: make([]byte, s)}, nil
+	}
+
+	bp.gets.Add(1)
+	c := bp.classes[i]
+	buf := c.p.Get().(*[]byte)
+	return &Lease{c: c, buf: buf, b: (*buf)[:s]}, nil
+}
+
+// Returns the counters of the leases so far.
+func (bp *BufPool) Stats() BufPoolStats {
+	// Every miss is counted after its lease, so loading the misses first keeps the hits positive
+	misses := bp.misses.Load()
+	return BufPoolStats{
+		Hits:     bp.gets.Load() - misses,
+		Misses:   misses,
+		Oversize: bp.oversize.Load(),
+	}
+}
+
+// Returns the leased bytes, they may not be used after Release.
+func (l *Lease) Bytes() []byte {
+	return l.b
+}
+
+// Puts the buffer back into the pool, neither the lease nor its bytes may be used afterwards.
+// Buffers larger than the largest size class are not pooled.
+//
+// !- Panics, if the lease was already released.
+func (l *Lease) Release() {
+	if l.b == nil {
+		panic("benc: lease released twice")
+	}
+	l.b = nil
+	if l.c != nil {
+		l.c.p.Put(l.buf)
+		l.buf = nil
+	}
+}
+
 // Initialises the marshal process, it reuses the buffers from a buf pool instance
 //
 // s = size of the data in bytes, retrieved by using the benc `Size...` methods
+//
+// Deprecated: The buffer is put back into the pool, before the returned bytes are used,
+// so an other goroutine may overwrite them. Use Get and release the lease after using its bytes.
 func (bp *BufPool) Marshal(s int, f func(b []byte) (n int)) ([]byte, error) {
-	ptr := bp.p.Get().(*[]byte)
-	slice := *ptr
-
-	if s > len(slice) {
-		return nil, ErrReuseBufTooSmall
+	l, err := bp.Get(s)
+	if err != nil {
+		return nil, err
 	}
 
-	b := slice[:s]
+	b := l.Bytes()
 	f(b)
-	*ptr = slice
-	bp.p.Put(ptr)
+	l.Release()
 
 	return b, nil
 }
 
+// Message is implemented by every container generated by bencgen.
+type Message interface {
+	// Returns the bytes needed to marshal the message.
+	Size() int
+	// Marshals the message into 'b', which has at least Size bytes.
+	Marshal(b []byte)
+	// Unmarshals the message from 'b'.
+	Unmarshal(b []byte) error
+}
+
+// PlainMessage is implemented by every container generated by bencgen, marshalled without tags,
+// so without backward and forward compatibility.
+type PlainMessage interface {
+	SizePlain() int
+	MarshalPlain(n int, b []byte) int
+	UnmarshalPlain(n int, b []byte) (int, error)
+}
+
+// NestedMessage is implemented by every container generated by bencgen, marshalled as the field 'id' of an other container.
+type NestedMessage interface {
+	NestedSize(id uint16) int
+	NestedMarshal(n int, b []byte, id uint16) int
+	NestedUnmarshal(n int, b []byte, r []uint16, id uint16) (int, error)
+}
+
+// Sealer seals marshalled messages into envelopes and opens them again, for example a bseal.Keyring.
+// Generated containers take one in MarshalSealed and UnmarshalSealed.
+type Sealer interface {
+	// Appends the envelope of 'plaintext' to 'dst' and returns the extended buffer.
+	Seal(dst []byte, plaintext []byte) ([]byte, error)
+	// Appends the plaintext of 'envelope' to 'dst' and returns the extended buffer.
+	Open(dst []byte, envelope []byte) ([]byte, error)
+}
+
+// Marshals 'm' into a new buffer.
+func MarshalToBytes[T Message](m T) []byte {
+	b := make([]byte, m.Size())
+	m.Marshal(b)
+	return b
+}
+
+// Marshals 'm' into a buffer leased from 'bp', release the lease, once the bytes are not used anymore.
+//
+// Possible errors returned:
+//   - ErrReuseBufTooSmall       - 'm' is larger than the largest size class and the pool doesn't grow, see WithBufferSize.
+func MarshalWithPool[T Message](bp *BufPool, m T) (*Lease, error) {
+	l, err := bp.Get(m.Size())
+	if err != nil {
+		return nil, err
+	}
+	m.Marshal(l.Bytes())
+	return l, nil
+}
+
 // Verifies that the length of the buffer equals n
 func VerifyMarshal(n int, b []byte) error {
 	if n != len(b) {
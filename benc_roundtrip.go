@@ -0,0 +1,249 @@
+package benc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrVerifyRoundTrip = errors.New("value changed in the marshal/unmarshal round trip")
+
+// The differences listed in a RoundTripError at most.
+const maxRoundTripDiffs = 20
+
+// RoundTripError lists, what VerifyRoundTrip found to differ.
+// It matches ErrVerifyRoundTrip with errors.Is.
+type RoundTripError struct {
+	// One line per difference, for example `Person.Child.Name: "Jr." != ""`, the original value comes first.
+	Diffs []string
+}
+
+func (e *RoundTripError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("benc: ")
+	sb.WriteString(ErrVerifyRoundTrip.Error())
+	sb.WriteString(":")
+	for _, d := range e.Diffs {
+		sb.WriteString("\n    ")
+		sb.WriteString(d)
+	}
+	return sb.String()
+}
+
+func (e *RoundTripError) Unwrap() error {
+	return ErrVerifyRoundTrip
+}
+
+// Marshals 'v', unmarshals it into a new value and compares both, field by field.
+// Then the new value is marshalled again, which has to result in the same bytes.
+// Use it in tests and debug builds, to find mistakes in the marshal or unmarshal process.
+//
+// Nil and empty slices and maps are equal, as they marshal to the same bytes.
+// Maps are compared by their entries. Without `--canonical`, the map order, so the bytes, differ
+// between the marshals, then the re-marshalled bytes are unmarshalled again and compared with 'v' instead.
+//
+// Possible errors returned:
+//   - *RoundTripError           - the values or the bytes differ.
+//   - every error, Unmarshal of 'v' returns.
+func VerifyRoundTrip[T Message](v T) error {
+	b := MarshalToBytes(v)
+
+	rv := reflect.ValueOf(v)
+	name := reflect.Indirect(rv).Type().Name()
+
+	retV, fresh := newMessage(rv)
+	if err := retV.Unmarshal(b); err != nil {
+		return err
+	}
+	if diffs := diffValues(name, rv, fresh, nil); len(diffs) > 0 {
+		return &RoundTripError{Diffs: diffs}
+	}
+
+	retB := MarshalToBytes(retV)
+	if bytes.Equal(b, retB) {
+		return nil
+	}
+	if !hasUnorderedMap(rv) || !marshalsVary(v, b) {
+		return &RoundTripError{Diffs: []string{diffBytes(b, retB)}}
+	}
+
+	// The maps are marshalled in map order, so only the entries of the re-marshalled bytes can be compared
+	retV, fresh = newMessage(rv)
+	if err := retV.Unmarshal(retB); err != nil {
+		return err
+	}
+	if diffs := diffValues(name, rv, fresh, nil); len(diffs) > 0 {
+		return &RoundTripError{Diffs: diffs}
+	}
+	return nil
+}
+
+// Returns a new message of the type of 'v' and its value, which is compared with 'v'.
+func newMessage(v reflect.Value) (Message, reflect.Value) {
+	var fresh reflect.Value
+	if v.Kind() == reflect.Pointer {
+		fresh = reflect.New(v.Type().Elem())
+	} else {
+		fresh = reflect.New(v.Type()).Elem()
+	}
+	return fresh.Interface().(Message), fresh
+}
+
+// The marshals of a value, that holds an unordered map, which have to result in the same bytes, until its maps count as canonical.
+// A map of two entries is marshalled in the same order by chance about every second time.
+const canonicalMarshals = 32
+
+// Returns true, if marshalling 'v' again results in other bytes than 'b', so its maps aren't marshalled canonically.
+func marshalsVary(v Message, b []byte) bool {
+	for i := 0; i < canonicalMarshals; i++ {
+		if !bytes.Equal(MarshalToBytes(v), b) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true, if 'v' holds a map of more than one entry, its entries may be marshalled in any order.
+func hasUnorderedMap(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return !v.IsNil() && hasUnorderedMap(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if hasUnorderedMap(v.Field(i)) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if hasUnorderedMap(v.Index(i)) {
+				return true
+			}
+		}
+	case reflect.Map:
+		if v.Len() > 1 {
+			return true
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			if hasUnorderedMap(iter.Key()) || hasUnorderedMap(iter.Value()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Appends a line to 'diffs' for every difference of 'a' and 'b', which are of the same type, at 'path'.
+func diffValues(path string, a reflect.Value, b reflect.Value, diffs []string) []string {
+	if len(diffs) >= maxRoundTripDiffs {
+		return diffs
+	}
+
+	add := func(format string, args ...any) []string {
+		if len(diffs) == maxRoundTripDiffs-1 {
+			return append(diffs, "... more differences")
+		}
+		return append(diffs, path+": "+fmt.Sprintf(format, args...))
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				return add("%s != %s", nilString(a), nilString(b))
+			}
+			return diffs
+		}
+		if a.Elem().Type() != b.Elem().Type() {
+			return add("%s != %s", a.Elem().Type(), b.Elem().Type())
+		}
+		return diffValues(path, a.Elem(), b.Elem(), diffs)
+	case reflect.Struct:
+		if a.Type() == timeType && a.CanInterface() {
+			at, bt := a.Interface().(time.Time), b.Interface().(time.Time)
+			if !at.Equal(bt) {
+				return add("%s != %s", at.Format(time.RFC3339Nano), bt.Format(time.RFC3339Nano))
+			}
+			return diffs
+		}
+		for i := 0; i < a.NumField(); i++ {
+			diffs = diffValues(path+"."+a.Type().Field(i).Name, a.Field(i), b.Field(i), diffs)
+		}
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return add("len %d != %d", a.Len(), b.Len())
+		}
+		for i := 0; i < a.Len(); i++ {
+			diffs = diffValues(path+"["+strconv.Itoa(i)+"]", a.Index(i), b.Index(i), diffs)
+		}
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return add("len %d != %d", a.Len(), b.Len())
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			k := iter.Key()
+			bv := b.MapIndex(k)
+			if !bv.IsValid() {
+				diffs = add("key %#v missing", k)
+				continue
+			}
+			diffs = diffValues(fmt.Sprintf("%s[%#v]", path, k), iter.Value(), bv, diffs)
+		}
+	case reflect.Float32, reflect.Float64:
+		// Compared bitwise, so NaN equals itself, as long as its bits survive
+		if math.Float64bits(a.Float()) != math.Float64bits(b.Float()) {
+			return add("%v != %v", a, b)
+		}
+	case reflect.Complex64, reflect.Complex128:
+		if a.Complex() != b.Complex() {
+			return add("%v != %v", a, b)
+		}
+	case reflect.Bool:
+		if a.Bool() != b.Bool() {
+			return add("%v != %v", a, b)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if a.Int() != b.Int() {
+			return add("%v != %v", a, b)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if a.Uint() != b.Uint() {
+			return add("%v != %v", a, b)
+		}
+	case reflect.String:
+		if a.String() != b.String() {
+			return add("%q != %q", a, b)
+		}
+	}
+	return diffs
+}
+
+func nilString(v reflect.Value) string {
+	if v.IsNil() {
+		return "nil"
+	}
+	return "non-nil"
+}
+
+// Describes the first difference of 'a' and 'b'.
+func diffBytes(a []byte, b []byte) string {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+
+	s := "re-marshalled bytes differ at offset " + strconv.Itoa(i)
+	if i < len(a) && i < len(b) {
+		s += fmt.Sprintf(": 0x%02x != 0x%02x", a[i], b[i])
+	}
+	return s + fmt.Sprintf(" (%d != %d bytes)", len(a), len(b))
+}
@@ -1,6 +1,13 @@
 package benc
 
-import "testing"
+import (
+	"errors"
+	"maps"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+)
 
 func TestBufPool(t *testing.T) {
 	bufPool := NewBufPool()
@@ -40,6 +47,160 @@ func TestBufPoolError(t *testing.T) {
 	}
 }
 
+func TestBufPoolLease(t *testing.T) {
+	bufPool := NewBufPool(WithBufferSize(16))
+
+	l, err := bufPool.Get(3)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(l.Bytes()) != 3 {
+		t.Fatalf("expected 3 bytes, got %d", len(l.Bytes()))
+	}
+	l.Release()
+
+	if _, err = bufPool.Get(17); err != ErrReuseBufTooSmall {
+		t.Fatal("expected a benc.ErrReuseBufTooSmall error!")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for a lease released twice")
+		}
+	}()
+	l.Release()
+}
+
+func TestBufPoolLeaseStaleRelease(t *testing.T) {
+	bufPool := NewBufPool(WithBufferSize(16))
+
+	stale, err := bufPool.Get(3)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	stale.Release()
+
+	// The new lease may get the buffer of the stale one, but never the same lease
+	l, err := bufPool.Get(3)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if l == stale {
+		t.Fatal("expected a new lease")
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected a panic for a stale lease released again")
+			}
+		}()
+		stale.Release()
+	}()
+
+	// The buffer of the new lease is still owned by it
+	copy(l.Bytes(), "abc")
+	other, err := bufPool.Get(3)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	copy(other.Bytes(), "xyz")
+	if string(l.Bytes()) != "abc" {
+		t.Fatalf("expected the leased bytes to be unchanged, got %q", l.Bytes())
+	}
+	other.Release()
+	l.Release()
+}
+
+func TestBufPoolSizeClasses(t *testing.T) {
+	bufPool := NewBufPool(WithPowerOfTwoClasses(64, 1<<20))
+	if bufPool.BufSize != 1<<20 {
+		t.Fatalf("expected the largest class to be %d, got %d", 1<<20, bufPool.BufSize)
+	}
+
+	for _, tt := range []struct{ s, class int }{{0, 64}, {50, 64}, {64, 64}, {65, 128}, {3000, 4096}, {1 << 20, 1 << 20}} {
+		l, err := bufPool.Get(tt.s)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(l.Bytes()) != tt.s || cap(l.Bytes()) != tt.class {
+			t.Fatalf("size %d: expected len %d and cap %d, got %d and %d", tt.s, tt.s, tt.class, len(l.Bytes()), cap(l.Bytes()))
+		}
+		l.Release()
+	}
+
+	// Larger leases are allocated, but not pooled
+	l, err := bufPool.Get(1<<20 + 1)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(l.Bytes()) != 1<<20+1 {
+		t.Fatalf("expected %d bytes, got %d", 1<<20+1, len(l.Bytes()))
+	}
+	l.Release()
+
+	stats := bufPool.Stats()
+	if stats.Hits+stats.Misses != 6 || stats.Misses == 0 || stats.Oversize != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+
+	bufPool = NewBufPool(WithSizeClasses(1000, 10, 100, 10))
+	if l, err = bufPool.Get(11); err != nil || cap(l.Bytes()) != 100 {
+		t.Fatalf("expected the class of 100 bytes, got %v", err)
+	}
+	l.Release()
+
+	// Only one size, without growing
+	bufPool = NewBufPool(WithBufferSize(8))
+	if _, err = bufPool.Get(9); err != ErrReuseBufTooSmall {
+		t.Fatal("expected a benc.ErrReuseBufTooSmall error!")
+	}
+	if stats = bufPool.Stats(); stats.Oversize != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for a pool without size classes")
+		}
+	}()
+	NewBufPool(WithPowerOfTwoClasses(128, 64))
+}
+
+// Run with -race: no lease may be handed out twice, before it is released.
+func TestBufPoolLeaseConcurrent(t *testing.T) {
+	bufPool := NewBufPool(WithPowerOfTwoClasses(16, 64))
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g byte) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				l, err := bufPool.Get(int(g)*10 + i%10)
+				if err != nil {
+					t.Error(err.Error())
+					return
+				}
+
+				b := l.Bytes()
+				for j := range b {
+					b[j] = g
+				}
+				runtime.Gosched()
+				for j := range b {
+					if b[j] != g {
+						t.Errorf("goroutine %d: byte %d overwritten by %d", g, j, b[j])
+						return
+					}
+				}
+				l.Release()
+			}
+		}(byte(g))
+	}
+	wg.Wait()
+}
+
 func TestVerifyMarshalAndUnmarshal(t *testing.T) {
 	if err := VerifyMarshal(3, []byte{1, 2, 3}); err != nil {
 		t.Fatal("benc.VerifyMarshal error: " + err.Error())
@@ -57,3 +218,172 @@ func TestVerifyMarshalAndUnmarshal(t *testing.T) {
 		t.Fatal("(benc.VerifyUnmarshal) expected an error")
 	}
 }
+
+func TestDecodeError(t *testing.T) {
+	err := NewDecodeError(12, "string", ErrBufTooSmall)
+	if !errors.Is(err, ErrBufTooSmall) {
+		t.Fatal("expected err to match benc.ErrBufTooSmall")
+	}
+
+	// The inner error is more precise, so it is kept.
+	if NewDecodeError(10, "slice", err) != err {
+		t.Fatal("expected the inner decode error to be returned")
+	}
+
+	err = WithDecodePath(err, "Child.name(2)")
+	err = WithDecodePath(err, "Person.child(4)")
+
+	var de *DecodeError
+	if !errors.As(err, &de) {
+		t.Fatal("expected a *benc.DecodeError")
+	}
+	if de.Offset != 12 || de.Type != "string" || len(de.Path) != 2 {
+		t.Fatalf("unexpected decode error: %+v", de)
+	}
+	if s := err.Error(); s != "benc: decode Person.child(4) -> Child.name(2) string at offset 12: buffer too small" {
+		t.Fatalf("unexpected message: %s", s)
+	}
+
+	err = WithDecodePath(ErrLimitExceeded, "Person.name(2)")
+	if !errors.Is(err, ErrLimitExceeded) {
+		t.Fatal("expected err to match benc.ErrLimitExceeded")
+	}
+	if s := err.Error(); s != "benc: decode Person.name(2): decode limit exceeded" {
+		t.Fatalf("unexpected message: %s", s)
+	}
+}
+
+type roundTripMsg struct {
+	A, B byte
+	Tags []string
+	swap bool
+}
+
+func (m *roundTripMsg) Size() int {
+	return 3 + len(m.Tags)
+}
+
+func (m *roundTripMsg) Marshal(b []byte) {
+	b[0], b[1], b[2] = m.A, m.B, byte(len(m.Tags))
+	for i, tag := range m.Tags {
+		b[3+i] = tag[0]
+	}
+}
+
+func (m *roundTripMsg) Unmarshal(b []byte) error {
+	if len(b) < 3 || len(b) != 3+int(b[2]) {
+		return ErrBufTooSmall
+	}
+	m.A, m.B = b[0], b[1]
+	if m.swap {
+		m.A, m.B = m.B, m.A
+	}
+	m.Tags = make([]string, b[2])
+	for i := range m.Tags {
+		m.Tags[i] = string(b[3+i])
+	}
+	return nil
+}
+
+// Always unmarshals with the fields swapped, as a fresh value has 'swap' set
+type swappedMsg struct{ roundTripMsg }
+
+func (m *swappedMsg) Unmarshal(b []byte) error {
+	m.swap = true
+	return m.roundTripMsg.Unmarshal(b)
+}
+
+// Marshals 'Raw' only as a nil flag, a nil 'Raw' is unmarshalled as an empty one, so the bytes differ
+type mapMsg struct {
+	M   map[byte]byte
+	Raw []byte
+}
+
+func (m *mapMsg) Size() int {
+	return 2 + 2*len(m.M)
+}
+
+func (m *mapMsg) Marshal(b []byte) {
+	m.marshal(b, slices.Collect(maps.Keys(m.M)))
+}
+
+func (m *mapMsg) marshal(b []byte, keys []byte) {
+	b[0], b[1] = 0, byte(len(m.M))
+	if m.Raw == nil {
+		b[0] = 1
+	}
+	for i, k := range keys {
+		b[2+2*i], b[3+2*i] = k, m.M[k]
+	}
+}
+
+func (m *mapMsg) Unmarshal(b []byte) error {
+	if len(b) < 2 || len(b) != 2+2*int(b[1]) {
+		return ErrBufTooSmall
+	}
+	m.Raw = []byte{}
+	m.M = make(map[byte]byte, b[1])
+	for i := 2; i < len(b); i += 2 {
+		m.M[b[i]] = b[i+1]
+	}
+	return nil
+}
+
+// Marshals the map entries in ascending key order
+type sortedMapMsg struct{ mapMsg }
+
+func (m *sortedMapMsg) Marshal(b []byte) {
+	m.marshal(b, slices.Sorted(maps.Keys(m.M)))
+}
+
+func TestVerifyRoundTripMaps(t *testing.T) {
+	m := make(map[byte]byte)
+	for i := byte(0); i < 20; i++ {
+		m[i] = i * 2
+	}
+
+	// The map order differs between the marshals, so the re-marshalled bytes are unmarshalled and compared
+	if err := VerifyRoundTrip(&mapMsg{M: m, Raw: []byte{}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := VerifyRoundTrip(&sortedMapMsg{mapMsg{M: m, Raw: []byte{}}}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// The map order never differs, so the bytes are compared
+	var rte *RoundTripError
+	if err := VerifyRoundTrip(&sortedMapMsg{mapMsg{M: m}}); !errors.As(err, &rte) || rte.Diffs[0] != "re-marshalled bytes differ at offset 0: 0x01 != 0x00 (42 != 42 bytes)" {
+		t.Fatalf("expected the bytes to differ, got %v", err)
+	}
+}
+
+func TestVerifyRoundTrip(t *testing.T) {
+	if err := VerifyRoundTrip(&roundTripMsg{A: 1, B: 2, Tags: []string{"a", "b"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	// Nil and empty slices marshal to the same bytes
+	if err := VerifyRoundTrip(&roundTripMsg{A: 1}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	err := VerifyRoundTrip(&swappedMsg{roundTripMsg{A: 1, B: 2}})
+	if !errors.Is(err, ErrVerifyRoundTrip) {
+		t.Fatalf("expected benc.ErrVerifyRoundTrip, got %v", err)
+	}
+	var rte *RoundTripError
+	if !errors.As(err, &rte) || len(rte.Diffs) != 3 {
+		t.Fatalf("expected 3 differences, got %v", err)
+	}
+	if rte.Diffs[0] != "swappedMsg.roundTripMsg.A: 1 != 2" || rte.Diffs[2] != "swappedMsg.roundTripMsg.swap: false != true" {
+		t.Fatalf("unexpected differences: %v", err)
+	}
+
+	// A multi-byte tag loses all bytes, but the first
+	if err = VerifyRoundTrip(&roundTripMsg{Tags: []string{"ab"}}); !errors.As(err, &rte) || rte.Diffs[0] != `roundTripMsg.Tags[0]: "ab" != "a"` {
+		t.Fatalf("unexpected differences: %v", err)
+	}
+
+	if got := diffBytes([]byte{1, 2, 3}, []byte{1, 2, 4, 5}); got != "re-marshalled bytes differ at offset 2: 0x03 != 0x04 (3 != 4 bytes)" {
+		t.Fatalf("unexpected byte difference: %s", got)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"slices"
 
+	"github.com/deneonet/benc/cmd/bencgen/lexer"
 	"github.com/deneonet/benc/cmd/bencgen/parser"
 	"github.com/deneonet/benc/cmd/bencgen/utils"
 )
@@ -24,6 +25,15 @@ func (lang GenLang) String() string {
 	}
 }
 
+type GenOpts struct {
+	// Writes map entries in sorted key order, so the same value always marshals to the same bytes.
+	CanonicalMaps bool
+	// Generates a view for every container, that iterates over its slices and maps, without unmarshalling the container.
+	Views bool
+	// Generates the framed variants of Size, Marshal and Unmarshal, see bframe.
+	Framed bool
+}
+
 type Gen interface {
 	File() string
 	Lang() GenLang
@@ -32,12 +42,20 @@ type Gen interface {
 	GenEnum() string
 	GenStruct() string
 	GenReservedIds() string
+	GenAssertions() string
 	GenSize() string
 	GenMarshal() string
 	GenUnmarshal() string
 	GenSizePlain() string
 	GenMarshalPlain() string
+	GenMarshalAppend() string
+	GenMarshalPlainAppend() string
 	GenUnmarshalPlain() string
+	GenUnmarshalReuse() string
+	GenUnmarshalPlainReuse() string
+	GenFramed() string
+	GenSealed() string
+	GenView() string
 
 	ProcessImport(stmt *parser.UseStmt, importDirs []string) ([]string, []string)
 
@@ -47,16 +65,17 @@ type Gen interface {
 
 	AddEnumDecls(enumDecls []string)
 	AddContainerDecls(containerDecls []string)
+	SetUsedTypes(usedTypes []lexer.Token)
 
 	SetEnumStatement(stmt *parser.EnumStmt)
 	SetDefineStatement(stmt *parser.DefineStmt)
 	SetContainerStatement(stmt *parser.ContainerStmt)
 }
 
-func NewGen(lang GenLang, file string) Gen {
+func NewGen(lang GenLang, file string, opts GenOpts) Gen {
 	switch lang {
 	case GoGenLang:
-		return NewGoGen(file)
+		return NewGoGen(file, opts)
 	default:
 		return nil
 	}
@@ -74,6 +93,7 @@ var disallowedNames = []string{"b", "n", "id", "r"}
 func Generate(g Gen, nodes []parser.Node, importDirs []string) string {
 	enumDecls := []string{}
 	containerDecls := []string{}
+	usedTypes := []lexer.Token{}
 
 	varMap := make(map[string]string)
 
@@ -97,6 +117,10 @@ func Generate(g Gen, nodes []parser.Node, importDirs []string) string {
 		case *parser.ContainerStmt:
 			validateCtrStmt(g, stmt, enumDecls, containerDecls)
 			containerDecls = append(containerDecls, stmt.Name)
+
+			for _, field := range stmt.Fields {
+				usedTypes = collectUsedTypes(usedTypes, field.Type)
+			}
 		case *parser.VarStmt:
 			varMap[stmt.Name] = stmt.Value
 		}
@@ -104,6 +128,7 @@ func Generate(g Gen, nodes []parser.Node, importDirs []string) string {
 
 	g.AddEnumDecls(enumDecls)
 	g.AddContainerDecls(containerDecls)
+	g.SetUsedTypes(usedTypes)
 
 	g.SetVarMap(varMap)
 
@@ -138,6 +163,22 @@ func Generate(g Gen, nodes []parser.Node, importDirs []string) string {
 	return res
 }
 
+func collectUsedTypes(usedTypes []lexer.Token, t *parser.Type) []lexer.Token {
+	switch {
+	case t.IsArray:
+		return collectUsedTypes(usedTypes, t.ChildType)
+	case t.IsMap:
+		return collectUsedTypes(collectUsedTypes(usedTypes, t.MapKeyType), t.ChildType)
+	case t.IsAnExternalStructure():
+		return usedTypes
+	}
+
+	if !slices.Contains(usedTypes, t.TokenType) {
+		usedTypes = append(usedTypes, t.TokenType)
+	}
+	return usedTypes
+}
+
 func validateCtrStmt(g Gen, stmt *parser.ContainerStmt, enumDecls []string, containerDecls []string) {
 	if slices.Contains(enumDecls, stmt.Name) {
 		LogErrorAndExit(g, fmt.Sprintf("A enum with the same name '%s' is already declared.", stmt.Name))
@@ -221,10 +262,18 @@ func validateEnumFields(g Gen, stmt *parser.EnumStmt) {
 func generateContainer(g Gen) string {
 	return g.GenStruct() +
 		g.GenReservedIds() +
+		g.GenAssertions() +
 		g.GenSize() +
 		g.GenSizePlain() +
 		g.GenMarshal() +
 		g.GenMarshalPlain() +
+		g.GenMarshalAppend() +
+		g.GenMarshalPlainAppend() +
 		g.GenUnmarshal() +
-		g.GenUnmarshalPlain()
+		g.GenUnmarshalPlain() +
+		g.GenUnmarshalReuse() +
+		g.GenUnmarshalPlainReuse() +
+		g.GenFramed() +
+		g.GenSealed() +
+		g.GenView()
 }
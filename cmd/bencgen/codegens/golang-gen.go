@@ -52,9 +52,11 @@ func (f *GoField) AppendReturnCopyIfPresent() string {
 
 type GoGen struct {
 	file string
+	opts GenOpts
 
 	enumDecls      []string
 	containerDecls []string
+	usedTypes      []lexer.Token
 
 	varMap map[string]string
 
@@ -62,6 +64,7 @@ type GoGen struct {
 	importedEnumsOrContainers map[string]string
 
 	plainGen   bool
+	reuseGen   bool
 	defineStmt *parser.DefineStmt
 
 	// currently generated...
@@ -71,8 +74,8 @@ type GoGen struct {
 	containerStmt GoContainerStmt
 }
 
-func NewGoGen(file string) *GoGen {
-	return &GoGen{file: file, importedEnumsOrContainers: make(map[string]string)}
+func NewGoGen(file string, opts GenOpts) *GoGen {
+	return &GoGen{file: file, opts: opts, importedEnumsOrContainers: make(map[string]string)}
 }
 
 func (g *GoGen) File() string {
@@ -178,6 +181,10 @@ func (g *GoGen) AddContainerDecls(containerDecls []string) {
 	g.containerDecls = append(g.containerDecls, containerDecls...)
 }
 
+func (g *GoGen) SetUsedTypes(usedTypes []lexer.Token) {
+	g.usedTypes = usedTypes
+}
+
 func (g *GoGen) ProcessImport(stmt *parser.UseStmt, importDirs []string) ([]string, []string) {
 	var content []byte
 	var err error
@@ -286,17 +293,29 @@ func (g *GoGen) GenDefine() string {
 
 	packageAlias := splitPackage[len(splitPackage)-1]
 
+	stdImports := ""
+	if slices.Contains(g.usedTypes, lexer.TIMESTAMP) || slices.Contains(g.usedTypes, lexer.DURATION) {
+		stdImports = "    \"time\"\n\n"
+	}
+
+	// Only imported, if the framed variants are generated, so bframe isn't a dependency otherwise
+	frameImport := ""
+	if g.opts.Framed {
+		frameImport = "    \"github.com/deneonet/benc/frame\"\n"
+	}
+
 	return fmt.Sprintf(
 		`package %s
 
 import (
+%s    "github.com/deneonet/benc"
     "github.com/deneonet/benc/std"
     "github.com/deneonet/benc/impl/gen"
-
+%s
 %s
 )
 
-`, packageAlias, g.joinImportedPackages())
+`, packageAlias, stdImports, frameImport, g.joinImportedPackages())
 }
 
 func joinUint16(ids []uint16) string {
@@ -316,6 +335,12 @@ func (g *GoGen) GenReservedIds() string {
 		ctr.DefaultName, ctr.PrivateName, joinUint16(ctr.ReservedIDs))
 }
 
+func (g *GoGen) GenAssertions() string {
+	ctr := g.containerStmt
+	return fmt.Sprintf("// Interfaces - %s\nvar _ benc.Message = (*%s)(nil)\nvar _ benc.PlainMessage = (*%s)(nil)\nvar _ benc.NestedMessage = (*%s)(nil)\n\n",
+		ctr.DefaultName, ctr.PublicName, ctr.PublicName, ctr.PublicName)
+}
+
 func (g *GoGen) GenStruct() string {
 	var sb strings.Builder
 	ctr := g.containerStmt
@@ -359,6 +384,12 @@ func (g *GoGen) getSizeFunc() string {
 	field := g.field
 
 	switch {
+	case field.Type.IsDelta:
+		return fmt.Sprintf("bstd.SizeDeltaSlice(%s.%s)", ctr.PrivateName, field.PublicName)
+	case field.Type.IsGorilla:
+		return fmt.Sprintf("bstd.SizeGorillaSlice(%s.%s)", ctr.PrivateName, field.PublicName)
+	case isPackedBoolSlice(field.Type):
+		return fmt.Sprintf("bstd.SizeBoolSlice(%s.%s)", ctr.PrivateName, field.PublicName)
 	case field.Type.IsArray:
 		if field.Type.ChildType.TokenType == lexer.STRING || field.Type.ChildType.TokenType == lexer.BYTES || field.Type.ChildType.IsAnExternalStructure() || field.Type.ChildType.IsMap || field.Type.ChildType.IsArray {
 			return fmt.Sprintf("bstd.SizeSlice(%s.%s, %s)",
@@ -368,8 +399,8 @@ func (g *GoGen) getSizeFunc() string {
 		return fmt.Sprintf("bstd.SizeFixedSlice(%s.%s, %s())",
 			ctr.PrivateName, field.PublicName, g.getElemSizeFunc(field.Type.ChildType))
 	case field.Type.IsMap:
-		return fmt.Sprintf("bstd.SizeMap(%s.%s, %s, %s)",
-			ctr.PrivateName, field.PublicName, g.getElemSizeFunc(field.Type.MapKeyType), g.getElemSizeFunc(field.Type.ChildType))
+		return fmt.Sprintf("bstd.SizeMapFunc(%s.%s, %s, %s)",
+			ctr.PrivateName, field.PublicName, g.getElemSizerFunc(field.Type.MapKeyType), g.getElemSizerFunc(field.Type.ChildType))
 	case field.Type.IsAnExternalStructure():
 		if g.IsEnum(field.Type.ExternalStructure) {
 			return fmt.Sprintf("bgenimpl.SizeEnum(%s.%s)",
@@ -412,8 +443,8 @@ func (g *GoGen) getElemSizeFunc(t *parser.Type) string {
 		return fmt.Sprintf("func (s %s) int { return bstd.SizeFixedSlice(s, %s()) }",
 			utils.BencTypeToGolang(t), g.getElemSizeFunc(t.ChildType))
 	case t.IsMap:
-		return fmt.Sprintf("func (s %s) int { return bstd.SizeMap(s, %s, %s) }",
-			utils.BencTypeToGolang(t), g.getElemSizeFunc(t.MapKeyType), g.getElemSizeFunc(t.ChildType))
+		return fmt.Sprintf("func (s %s) int { return bstd.SizeMapFunc(s, %s, %s) }",
+			utils.BencTypeToGolang(t), g.getElemSizerFunc(t.MapKeyType), g.getElemSizerFunc(t.ChildType))
 	case t.IsAnExternalStructure():
 		if g.IsEnum(t.ExternalStructure) {
 			return "bgenimpl.SizeEnum"
@@ -426,6 +457,56 @@ func (g *GoGen) getElemSizeFunc(t *parser.Type) string {
 	}
 }
 
+// Returns true, if the size of the type doesn't depend on its value, e.g. `bstd.SizeInt32()`.
+func (g *GoGen) isFixedSize(t *parser.Type) bool {
+	if t.IsArray || t.IsMap || t.IsAnExternalStructure() {
+		return false
+	}
+	switch t.TokenType {
+	case lexer.STRING, lexer.BYTES, lexer.INT, lexer.UINT:
+		return false
+	}
+	return true
+}
+
+// Returns the name of the bstd slice functions of the array, e.g. `Float64` for bstd.MarshalFloat64Slice,
+// if its elements are fixed-width numbers, that are copied at once.
+func getFixedSliceName(t *parser.Type) (string, bool) {
+	c := t.ChildType
+	if !t.IsArray || c.IsArray || c.IsMap || c.IsAnExternalStructure() {
+		return "", false
+	}
+	switch c.TokenType {
+	case lexer.INT16, lexer.INT32, lexer.INT64, lexer.UINT16, lexer.UINT32, lexer.UINT64, lexer.FLOAT32, lexer.FLOAT64:
+		return c.TokenType.String(), true
+	}
+	return "", false
+}
+
+// Returns true, if the type is a bool array, that is marshalled packed, 8 bools per byte, see bstd.MarshalBoolSlice.
+// Only fields are packed: their tag tells packed bools from the unpacked ones of an older bencgen apart,
+// bool arrays in other arrays or maps have no tag, so they stay unpacked, to stay compatible.
+func isPackedBoolSlice(t *parser.Type) bool {
+	c := t.ChildType
+	return t.IsArray && !c.IsArray && !c.IsMap && !c.IsAnExternalStructure() && c.TokenType == lexer.BOOL
+}
+
+// Returns the bgenimpl type of the field, that is written into its tag.
+func (g *GoGen) getBgenimplType(t *parser.Type) string {
+	if isPackedBoolSlice(t) {
+		return "PackedBools"
+	}
+	return g.mapTokenTypeToBgenimplType(t.TokenType)
+}
+
+// Returns the bstd.SizeFunc of the type, fixed sizes are wrapped with bstd.FixedSize.
+func (g *GoGen) getElemSizerFunc(t *parser.Type) string {
+	if g.isFixedSize(t) {
+		return fmt.Sprintf("bstd.FixedSize[%s](%s())", utils.BencTypeToGolang(t), g.getElemSizeFunc(t))
+	}
+	return g.getElemSizeFunc(t)
+}
+
 func (g *GoGen) GenSize() string {
 	var sb strings.Builder
 	ctr := g.containerStmt
@@ -475,17 +556,47 @@ func (g *GoGen) GenSizePlain() string {
 	return sb.String()
 }
 
+// Returns the bstd function, that marshals or appends ('op') a map with keys of type 't'.
+// With canonical maps, ordered keys are sorted by value, all other keys by their marshalled bytes.
+func (g *GoGen) getMapFunc(op string, t *parser.Type) string {
+	if !g.opts.CanonicalMaps {
+		return "bstd." + op + "Map"
+	}
+
+	if t.IsAnExternalStructure() {
+		if g.IsEnum(t.ExternalStructure) {
+			return "bstd." + op + "SortedMap"
+		}
+		return "bstd." + op + "CanonicalMap"
+	}
+
+	switch t.TokenType {
+	case lexer.BOOL, lexer.TIMESTAMP:
+		return "bstd." + op + "CanonicalMap"
+	}
+	return "bstd." + op + "SortedMap"
+}
+
 func (g *GoGen) getMarshalFunc() string {
 	ctr := g.containerStmt
 	field := g.field
 
 	switch {
+	case field.Type.IsDelta:
+		return fmt.Sprintf("bstd.MarshalDeltaSlice(n, b, %s.%s)", ctr.PrivateName, field.PublicName)
+	case field.Type.IsGorilla:
+		return fmt.Sprintf("bstd.MarshalGorillaSlice(n, b, %s.%s)", ctr.PrivateName, field.PublicName)
+	case isPackedBoolSlice(field.Type):
+		return fmt.Sprintf("bstd.MarshalBoolSlice(n, b, %s.%s)", ctr.PrivateName, field.PublicName)
 	case field.Type.IsArray:
+		if name, ok := getFixedSliceName(field.Type); ok {
+			return fmt.Sprintf("bstd.Marshal%sSlice(n, b, %s.%s)", name, ctr.PrivateName, field.PublicName)
+		}
 		return fmt.Sprintf("bstd.MarshalSlice(n, b, %s.%s, %s)",
 			ctr.PrivateName, field.PublicName, g.getElemMarshalFunc(field.Type.ChildType))
 	case field.Type.IsMap:
-		return fmt.Sprintf("bstd.MarshalMap(n, b, %s.%s, %s, %s)",
-			ctr.PrivateName, field.PublicName, g.getElemMarshalFunc(field.Type.MapKeyType), g.getElemMarshalFunc(field.Type.ChildType))
+		return fmt.Sprintf("%s(n, b, %s.%s, %s, %s)",
+			g.getMapFunc("Marshal", field.Type.MapKeyType), ctr.PrivateName, field.PublicName, g.getElemMarshalFunc(field.Type.MapKeyType), g.getElemMarshalFunc(field.Type.ChildType))
 	case field.Type.IsAnExternalStructure():
 		if g.IsEnum(field.Type.ExternalStructure) {
 			return fmt.Sprintf("bgenimpl.MarshalEnum(n, b, %s.%s)",
@@ -507,11 +618,14 @@ func (g *GoGen) getMarshalFunc() string {
 func (g *GoGen) getElemMarshalFunc(t *parser.Type) string {
 	switch {
 	case t.IsArray:
+		if name, ok := getFixedSliceName(t); ok {
+			return "bstd.Marshal" + name + "Slice"
+		}
 		return fmt.Sprintf("func (n int, b []byte, s %s) int { return bstd.MarshalSlice(n, b, s, %s) }",
 			utils.BencTypeToGolang(t), g.getElemMarshalFunc(t.ChildType))
 	case t.IsMap:
-		return fmt.Sprintf("func (n int, b []byte, s %s) int { return bstd.MarshalMap(n, b, s, %s, %s) }",
-			utils.BencTypeToGolang(t), g.getElemMarshalFunc(t.MapKeyType), g.getElemMarshalFunc(t.ChildType))
+		return fmt.Sprintf("func (n int, b []byte, s %s) int { return %s(n, b, s, %s, %s) }",
+			utils.BencTypeToGolang(t), g.getMapFunc("Marshal", t.MapKeyType), g.getElemMarshalFunc(t.MapKeyType), g.getElemMarshalFunc(t.ChildType))
 	case t.IsAnExternalStructure():
 		if g.IsEnum(t.ExternalStructure) {
 			return "bgenimpl.MarshalEnum"
@@ -539,7 +653,7 @@ func (g *GoGen) GenMarshal() string {
 
 		if !g.IsContainer(field.Type.ExternalStructure) {
 			sb.WriteString(fmt.Sprintf("    n = bgenimpl.MarshalTag(n, b, bgenimpl.%s, %d)\n",
-				g.mapTokenTypeToBgenimplType(field.Type.TokenType), field.ID))
+				g.getBgenimplType(field.Type), field.ID))
 		}
 		sb.WriteString(fmt.Sprintf("    n = %s\n", g.getMarshalFunc()))
 	})
@@ -566,6 +680,109 @@ func (g *GoGen) GenMarshalPlain() string {
 	return sb.String()
 }
 
+func (g *GoGen) getAppendFunc() string {
+	ctr := g.containerStmt
+	field := g.field
+
+	switch {
+	case field.Type.IsDelta:
+		return fmt.Sprintf("bstd.AppendDeltaSlice(b, %s.%s)", ctr.PrivateName, field.PublicName)
+	case field.Type.IsGorilla:
+		return fmt.Sprintf("bstd.AppendGorillaSlice(b, %s.%s)", ctr.PrivateName, field.PublicName)
+	case isPackedBoolSlice(field.Type):
+		return fmt.Sprintf("bstd.AppendBoolSlice(b, %s.%s)", ctr.PrivateName, field.PublicName)
+	case field.Type.IsArray:
+		if name, ok := getFixedSliceName(field.Type); ok {
+			return fmt.Sprintf("bstd.Append%sSlice(b, %s.%s)", name, ctr.PrivateName, field.PublicName)
+		}
+		return fmt.Sprintf("bstd.AppendSlice(b, %s.%s, %s)",
+			ctr.PrivateName, field.PublicName, g.getElemAppendFunc(field.Type.ChildType))
+	case field.Type.IsMap:
+		return fmt.Sprintf("%s(b, %s.%s, %s, %s)",
+			g.getMapFunc("Append", field.Type.MapKeyType), ctr.PrivateName, field.PublicName, g.getElemAppendFunc(field.Type.MapKeyType), g.getElemAppendFunc(field.Type.ChildType))
+	case field.Type.IsAnExternalStructure():
+		if g.IsEnum(field.Type.ExternalStructure) {
+			return fmt.Sprintf("bgenimpl.AppendEnum(b, %s.%s)",
+				ctr.PrivateName, field.PublicName)
+		}
+
+		if g.plainGen {
+			return fmt.Sprintf("%s.%s.MarshalPlainAppend(b)",
+				ctr.PrivateName, field.PublicName)
+		}
+		return fmt.Sprintf("%s.%s.NestedMarshalAppend(b, %d)",
+			ctr.PrivateName, field.PublicName, field.ID)
+	default:
+		return fmt.Sprintf("bstd.Append%s%s(b, %s.%s)",
+			field.AppendUnsafeIfPresent(), field.Type.TokenType.String(), ctr.PrivateName, field.PublicName)
+	}
+}
+
+func (g *GoGen) getElemAppendFunc(t *parser.Type) string {
+	switch {
+	case t.IsArray:
+		if name, ok := getFixedSliceName(t); ok {
+			return "bstd.Append" + name + "Slice"
+		}
+		return fmt.Sprintf("func (b []byte, s %s) []byte { return bstd.AppendSlice(b, s, %s) }",
+			utils.BencTypeToGolang(t), g.getElemAppendFunc(t.ChildType))
+	case t.IsMap:
+		return fmt.Sprintf("func (b []byte, s %s) []byte { return %s(b, s, %s, %s) }",
+			utils.BencTypeToGolang(t), g.getMapFunc("Append", t.MapKeyType), g.getElemAppendFunc(t.MapKeyType), g.getElemAppendFunc(t.ChildType))
+	case t.IsAnExternalStructure():
+		if g.IsEnum(t.ExternalStructure) {
+			return "bgenimpl.AppendEnum"
+		}
+
+		return fmt.Sprintf("func (b []byte, s %s) []byte { return s.MarshalPlainAppend(b) }",
+			makeExternalStructureUpperOrNot(t.ExternalStructure))
+	default:
+		return "bstd.Append" + t.AppendUnsafeIfPresent() + t.TokenType.String()
+	}
+}
+
+func (g *GoGen) GenMarshalAppend() string {
+	var sb strings.Builder
+	ctr := g.containerStmt
+
+	sb.WriteString(fmt.Sprintf("// MarshalAppend - %s\nfunc (%s *%s) MarshalAppend(b []byte) []byte {\n    return %s.NestedMarshalAppend(b, 0)\n}\n\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
+
+	sb.WriteString(fmt.Sprintf("// Nested MarshalAppend - %s\nfunc (%s *%s) NestedMarshalAppend(b []byte, id uint16) []byte {\n    b = bgenimpl.AppendTag(b, bgenimpl.Container, id)\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName))
+
+	g.ForEachCtrFields(func(_ int) {
+		field := g.field
+
+		if !g.IsContainer(field.Type.ExternalStructure) {
+			sb.WriteString(fmt.Sprintf("    b = bgenimpl.AppendTag(b, bgenimpl.%s, %d)\n",
+				g.getBgenimplType(field.Type), field.ID))
+		}
+		sb.WriteString(fmt.Sprintf("    b = %s\n", g.getAppendFunc()))
+	})
+
+	sb.WriteString("\n    return append(b, 1, 1)\n}\n\n")
+	return sb.String()
+}
+
+func (g *GoGen) GenMarshalPlainAppend() string {
+	var sb strings.Builder
+	ctr := g.containerStmt
+
+	g.plainGen = true
+	defer func() { g.plainGen = false }()
+
+	sb.WriteString(fmt.Sprintf("// MarshalPlainAppend - %s\nfunc (%s *%s) MarshalPlainAppend(b []byte) []byte {\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName))
+
+	g.ForEachCtrFields(func(_ int) {
+		sb.WriteString(fmt.Sprintf("    b = %s\n", g.getAppendFunc()))
+	})
+
+	sb.WriteString("    return b\n}\n\n")
+	return sb.String()
+}
+
 func (g *GoGen) mapTokenTypeToBgenimplType(t lexer.Token) string {
 	switch t {
 	case lexer.INT, lexer.UINT:
@@ -578,56 +795,144 @@ func (g *GoGen) mapTokenTypeToBgenimplType(t lexer.Token) string {
 		return "Fixed16"
 	case lexer.INT32, lexer.UINT32, lexer.FLOAT32:
 		return "Fixed32"
-	case lexer.INT64, lexer.UINT64, lexer.FLOAT64:
+	case lexer.INT64, lexer.UINT64, lexer.FLOAT64, lexer.DURATION:
 		return "Fixed64"
+	case lexer.TIMESTAMP:
+		return "Fixed128"
 	default:
 		return "ArrayMap"
 	}
 }
 
+// Returns the package (bstd) or the limits (l), that unmarshal the type.
+// Strings and byte slices are unmarshalled by the limits, to check their length.
+func getUnmarshalReceiver(t *parser.Type) string {
+	if t.TokenType == lexer.STRING || t.TokenType == lexer.BYTES {
+		return "l"
+	}
+	return "bstd"
+}
+
 func (g *GoGen) getUnmarshalFunc() string {
 	ctr := g.containerStmt
 	field := g.field
 
 	switch {
-	case field.Type.IsArray:
-		return fmt.Sprintf("bstd.UnmarshalSlice[%s](n, b, %s)",
-			utils.BencTypeToGolang(field.Type.ChildType), g.getElemUnmarshalFunc(field.Type.ChildType))
-	case field.Type.IsMap:
-		return fmt.Sprintf("bstd.UnmarshalMap[%s, %s](n, b, %s, %s)",
-			utils.BencTypeToGolang(field.Type.MapKeyType), utils.BencTypeToGolang(field.Type.ChildType), g.getElemUnmarshalFunc(field.Type.MapKeyType), g.getElemUnmarshalFunc(field.Type.ChildType))
+	case field.Type.IsDelta:
+		if g.reuseGen {
+			return fmt.Sprintf("bstd.UnmarshalDeltaSliceIntoLimited(l, n, b, %s.%s)", ctr.PrivateName, field.PublicName)
+		}
+		return fmt.Sprintf("bstd.UnmarshalDeltaSliceLimited[%s](l, n, b)", utils.BencTypeToGolang(field.Type.ChildType))
+	case field.Type.IsGorilla:
+		if g.reuseGen {
+			return fmt.Sprintf("bstd.UnmarshalGorillaSliceIntoLimited(l, n, b, %s.%s)", ctr.PrivateName, field.PublicName)
+		}
+		return "bstd.UnmarshalGorillaSliceLimited(l, n, b)"
+	case isPackedBoolSlice(field.Type):
+		dst := "nil"
+		if g.reuseGen {
+			dst = ctr.PrivateName + "." + field.PublicName
+		}
+		// Plain containers have no tags, they are always packed
+		if g.plainGen {
+			return fmt.Sprintf("bstd.UnmarshalBoolSliceIntoLimited(l, n, b, %s)", dst)
+		}
+		return fmt.Sprintf("bgenimpl.UnmarshalBoolSliceField(l, typ, n, b, %s)", dst)
+	case field.Type.IsArray, field.Type.IsMap:
+		return g.getCollectionUnmarshalFunc(field.Type)
 	case field.Type.IsAnExternalStructure():
 		if g.IsEnum(field.Type.ExternalStructure) {
 			return fmt.Sprintf("bgenimpl.UnmarshalEnum[%s](n, b)", field.Type.ExternalStructure)
 		}
 		if g.plainGen {
-			return fmt.Sprintf("%s.%s.UnmarshalPlain(n, b)", ctr.PrivateName, field.PublicName)
+			return fmt.Sprintf("%s.%s.%s(n, b, l)", ctr.PrivateName, field.PublicName, g.getUnmarshalPlainName())
 		}
 		return fmt.Sprintf("bstd.Unmarshal%s%s%s(n, b)", field.AppendUnsafeIfPresent(), field.Type.TokenType.String(), field.AppendReturnCopyIfPresent())
 	default:
-		return fmt.Sprintf("bstd.Unmarshal%s%s%s(n, b)", field.AppendUnsafeIfPresent(), field.Type.TokenType.String(), field.AppendReturnCopyIfPresent())
+		return fmt.Sprintf("%s.Unmarshal%s%s%s(n, b)", getUnmarshalReceiver(field.Type), field.AppendUnsafeIfPresent(), field.Type.TokenType.String(), field.AppendReturnCopyIfPresent())
+	}
+}
+
+// Returns the name of the plain unmarshal method, that is called on nested containers.
+func (g *GoGen) getUnmarshalPlainName() string {
+	if g.reuseGen {
+		return "UnmarshalPlainReuse"
 	}
+	return "UnmarshalPlainLimited"
 }
 
+// Returns the name of the nested unmarshal method, that is called on nested containers.
+func (g *GoGen) getNestedUnmarshalName() string {
+	if g.reuseGen {
+		return "NestedUnmarshalReuse"
+	}
+	return "NestedUnmarshalLimited"
+}
+
+// Returns the call, that unmarshals the slice or map at 'n'.
+// Slices of containers are unmarshalled in place, see bstd.UnmarshalSliceInto.
+// When reusing, the slice or map of the current field is passed as destination, see bstd.UnmarshalMapInto.
+func (g *GoGen) getCollectionUnmarshalFunc(t *parser.Type) string {
+	dst := "nil"
+	if g.reuseGen && t == g.field.Type {
+		dst = g.containerStmt.PrivateName + "." + g.field.PublicName
+	}
+
+	if t.IsMap {
+		if dst != "nil" {
+			return fmt.Sprintf("bstd.UnmarshalMapIntoLimited[%s, %s](l, n, b, %s, %s, %s)",
+				utils.BencTypeToGolang(t.MapKeyType), utils.BencTypeToGolang(t.ChildType), dst, g.getElemUnmarshalFunc(t.MapKeyType), g.getElemUnmarshalFunc(t.ChildType))
+		}
+		return fmt.Sprintf("bstd.UnmarshalMapFuncLimited[%s, %s](l, n, b, %s, %s)",
+			utils.BencTypeToGolang(t.MapKeyType), utils.BencTypeToGolang(t.ChildType), g.getElemUnmarshalFunc(t.MapKeyType), g.getElemUnmarshalFunc(t.ChildType))
+	}
+
+	if name, ok := getFixedSliceName(t); ok {
+		if dst != "nil" {
+			return fmt.Sprintf("bstd.Unmarshal%sSliceIntoLimited(l, n, b, %s)", name, dst)
+		}
+		return fmt.Sprintf("bstd.Unmarshal%sSliceLimited(l, n, b)", name)
+	}
+
+	if g.isContainerType(t.ChildType) {
+		return fmt.Sprintf("bstd.UnmarshalSliceIntoLimited[%s](l, n, b, %s, func (n int, b []byte, s *%s) (int, error) { return s.%s(n, b, l) })",
+			utils.BencTypeToGolang(t.ChildType), dst, makeExternalStructureUpperOrNot(t.ChildType.ExternalStructure), g.getUnmarshalPlainName())
+	}
+	if dst != "nil" {
+		return fmt.Sprintf("bstd.UnmarshalSliceIntoLimited[%s](l, n, b, %s, bstd.ToUnmarshalIntoFunc(%s))",
+			utils.BencTypeToGolang(t.ChildType), dst, g.getElemUnmarshalFunc(t.ChildType))
+	}
+	return fmt.Sprintf("bstd.UnmarshalSliceFuncLimited[%s](l, n, b, %s)",
+		utils.BencTypeToGolang(t.ChildType), g.getElemUnmarshalFunc(t.ChildType))
+}
+
+// Returns true, if the type is a container, not an enum.
+func (g *GoGen) isContainerType(t *parser.Type) bool {
+	return t.IsAnExternalStructure() && !g.IsEnum(t.ExternalStructure)
+}
+
+// Returns the bstd.UnmarshalFunc of the type.
 func (g *GoGen) getElemUnmarshalFunc(t *parser.Type) string {
 	switch {
-	case t.IsArray:
-		return fmt.Sprintf("func (n int, b []byte) (int, %s, error) { return bstd.UnmarshalSlice[%s](n, b, %s) }",
-			utils.BencTypeToGolang(t), utils.BencTypeToGolang(t.ChildType), g.getElemUnmarshalFunc(t.ChildType))
-	case t.IsMap:
-		return fmt.Sprintf("func (n int, b []byte) (int, %s, error) { return bstd.UnmarshalMap[%s, %s](n, b, %s, %s) }",
-			utils.BencTypeToGolang(t), utils.BencTypeToGolang(t.MapKeyType), utils.BencTypeToGolang(t.ChildType), g.getElemUnmarshalFunc(t.MapKeyType), g.getElemUnmarshalFunc(t.ChildType))
+	case t.IsArray, t.IsMap:
+		return fmt.Sprintf("func (n int, b []byte) (int, %s, error) { return %s }",
+			utils.BencTypeToGolang(t), g.getCollectionUnmarshalFunc(t))
 	case t.IsAnExternalStructure():
 		if g.IsEnum(t.ExternalStructure) {
-			return "bgenimpl.UnmarshalEnum"
+			return fmt.Sprintf("bgenimpl.UnmarshalEnum[%s]", makeExternalStructureUpperOrNot(t.ExternalStructure))
 		}
-		return fmt.Sprintf("func (n int, b []byte, s *%s) (int, error) { return s.UnmarshalPlain(n, b) }",
+		return fmt.Sprintf("bstd.ToUnmarshalFunc(func (n int, b []byte, s *%s) (int, error) { return s.UnmarshalPlainLimited(n, b, l) })",
 			makeExternalStructureUpperOrNot(t.ExternalStructure))
 	default:
-		return "bstd.Unmarshal" + t.AppendUnsafeIfPresent() + t.TokenType.String() + t.AppendReturnCopyIfPresent()
+		return getUnmarshalReceiver(t) + ".Unmarshal" + t.AppendUnsafeIfPresent() + t.TokenType.String() + t.AppendReturnCopyIfPresent()
 	}
 }
 
+// Returns the call, that adds the current container and field to the path of 'err', see bgenimpl.WrapFieldError.
+func (g *GoGen) getWrapFieldError() string {
+	return fmt.Sprintf("bgenimpl.WrapFieldError(err, \"%s\", \"%s\", %d)", g.containerStmt.DefaultName, g.field.DefaultName, g.field.ID)
+}
+
 func (g *GoGen) GenUnmarshal() string {
 	var sb strings.Builder
 	ctr := g.containerStmt
@@ -635,27 +940,58 @@ func (g *GoGen) GenUnmarshal() string {
 	sb.WriteString(fmt.Sprintf("// Unmarshal - %s\nfunc (%s *%s) Unmarshal(b []byte) (err error) {\n    _, err = %s.NestedUnmarshal(0, b, []uint16{}, 0)\n    return\n}\n\n",
 		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
 
-	sb.WriteString(fmt.Sprintf("// Nested Unmarshal - %s\nfunc (%s *%s) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {\n    var ok bool\n    if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {\n        if err == bgenimpl.ErrEof {\n            return n, nil\n        }\n        return\n    }\n",
-		ctr.DefaultName, ctr.PrivateName, ctr.PublicName))
+	sb.WriteString(fmt.Sprintf("// UnmarshalLimited - %s\nfunc (%s *%s) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {\n    _, err = %s.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)\n    return\n}\n\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
+
+	sb.WriteString(fmt.Sprintf("// Nested Unmarshal - %s\nfunc (%s *%s) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {\n    return %s.NestedUnmarshalLimited(tn, b, r, id, nil)\n}\n\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
+
+	sb.WriteString(fmt.Sprintf("// Nested UnmarshalLimited - %s\n", ctr.DefaultName))
+	g.writeNestedUnmarshal(&sb)
+	return sb.String()
+}
+
+// Writes the nested unmarshal method, NestedUnmarshalLimited or NestedUnmarshalReuse.
+func (g *GoGen) writeNestedUnmarshal(sb *strings.Builder) {
+	ctr := g.containerStmt
+
+	sb.WriteString(fmt.Sprintf("func (%s *%s) %s(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {\n",
+		ctr.PrivateName, ctr.PublicName, g.getNestedUnmarshalName()))
+	if g.reuseGen {
+		// Fields missing in 'b' must not keep the values of the previous unmarshal
+		sb.WriteString(fmt.Sprintf("    %s.Reset()\n", ctr.PrivateName))
+	}
+	sb.WriteString("    var ok bool\n")
+	if slices.ContainsFunc(ctr.Fields, func(f parser.Field) bool { return isPackedBoolSlice(f.Type) }) {
+		sb.WriteString("    var typ byte\n")
+	}
+	sb.WriteString("    if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {\n        if err == bgenimpl.ErrEof {\n            return n, nil\n        }\n        return\n    }\n")
 
 	g.ForEachCtrFields(func(_ int) {
 		field := g.field
 
+		wrap := g.getWrapFieldError()
+
 		if g.IsContainer(field.Type.ExternalStructure) {
-			sb.WriteString(fmt.Sprintf("    if n, err = %s.%s.NestedUnmarshal(n, b, %sRIds, %d); err != nil {\n        return\n    }\n",
-				ctr.PrivateName, field.PublicName, ctr.PrivateName, field.ID))
+			sb.WriteString(fmt.Sprintf("    if n, err = %s.%s.%s(n, b, %sRIds, %d, l); err != nil {\n        return 0, %s\n    }\n",
+				ctr.PrivateName, field.PublicName, g.getNestedUnmarshalName(), ctr.PrivateName, field.ID, wrap))
 			return
 		}
 
-		sb.WriteString(fmt.Sprintf("    if n, ok, err = bgenimpl.HandleCompatibility(n, b, %sRIds, %d); err != nil {\n        if err == bgenimpl.ErrEof {\n            return n, nil\n        }\n        return\n    }\n",
-			ctr.PrivateName, field.ID))
+		// The tag decides, whether the bools are packed, see bgenimpl.UnmarshalBoolSliceField
+		if isPackedBoolSlice(field.Type) {
+			sb.WriteString(fmt.Sprintf("    if n, typ, ok, err = bgenimpl.HandleCompatibilityType(n, b, %sRIds, %d); err != nil {\n        if err == bgenimpl.ErrEof {\n            return n, nil\n        }\n        return 0, %s\n    }\n",
+				ctr.PrivateName, field.ID, wrap))
+		} else {
+			sb.WriteString(fmt.Sprintf("    if n, ok, err = bgenimpl.HandleCompatibility(n, b, %sRIds, %d); err != nil {\n        if err == bgenimpl.ErrEof {\n            return n, nil\n        }\n        return 0, %s\n    }\n",
+				ctr.PrivateName, field.ID, wrap))
+		}
 
-		sb.WriteString(fmt.Sprintf("    if ok {\n        if n, %s.%s, err = %s; err != nil {\n            return\n        }\n    }\n",
-			ctr.PrivateName, field.PublicName, g.getUnmarshalFunc()))
+		sb.WriteString(fmt.Sprintf("    if ok {\n        if n, %s.%s, err = %s; err != nil {\n            return 0, %s\n        }\n    }\n",
+			ctr.PrivateName, field.PublicName, g.getUnmarshalFunc(), wrap))
 	})
 
-	sb.WriteString("    n += 2\n    return\n}\n\n")
-	return sb.String()
+	sb.WriteString("    return bgenimpl.SkipContainerEnd(n, b)\n}\n\n")
 }
 
 func (g *GoGen) GenUnmarshalPlain() string {
@@ -665,22 +1001,183 @@ func (g *GoGen) GenUnmarshalPlain() string {
 	g.plainGen = true
 	defer func() { g.plainGen = false }()
 
-	sb.WriteString(fmt.Sprintf("// UnmarshalPlain - %s\nfunc (%s *%s) UnmarshalPlain(tn int, b []byte) (n int, err error) {\n    n = tn\n",
-		ctr.DefaultName, ctr.PrivateName, ctr.PublicName))
+	sb.WriteString(fmt.Sprintf("// UnmarshalPlain - %s\nfunc (%s *%s) UnmarshalPlain(tn int, b []byte) (n int, err error) {\n    return %s.UnmarshalPlainLimited(tn, b, nil)\n}\n\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
+
+	sb.WriteString(fmt.Sprintf("// UnmarshalPlainLimited - %s\n", ctr.DefaultName))
+	g.writeUnmarshalPlain(&sb)
+	return sb.String()
+}
+
+// Writes the plain unmarshal method, UnmarshalPlainLimited or UnmarshalPlainReuse.
+func (g *GoGen) writeUnmarshalPlain(sb *strings.Builder) {
+	ctr := g.containerStmt
+
+	sb.WriteString(fmt.Sprintf("func (%s *%s) %s(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {\n    n = tn\n",
+		ctr.PrivateName, ctr.PublicName, g.getUnmarshalPlainName()))
 
 	g.ForEachCtrFields(func(_ int) {
 		field := g.field
 
+		wrap := g.getWrapFieldError()
+
 		if g.IsContainer(field.Type.ExternalStructure) {
-			sb.WriteString(fmt.Sprintf("    if n, err = %s; err != nil {\n        return\n    }\n",
-				g.getUnmarshalFunc()))
+			sb.WriteString(fmt.Sprintf("    if n, err = %s; err != nil {\n        return 0, %s\n    }\n",
+				g.getUnmarshalFunc(), wrap))
 			return
 		}
 
-		sb.WriteString(fmt.Sprintf("    if n, %s.%s, err = %s; err != nil {\n        return\n    }\n",
-			ctr.PrivateName, field.PublicName, g.getUnmarshalFunc()))
+		sb.WriteString(fmt.Sprintf("    if n, %s.%s, err = %s; err != nil {\n        return 0, %s\n    }\n",
+			ctr.PrivateName, field.PublicName, g.getUnmarshalFunc(), wrap))
 	})
 
 	sb.WriteString("    return\n}\n\n")
+}
+
+// Generates the reuse mode of Unmarshal: slices and maps are unmarshalled into the ones,
+// that the container already holds, so their memory is reused, see bstd.UnmarshalSliceInto and bstd.UnmarshalMapInto.
+func (g *GoGen) GenUnmarshalReuse() string {
+	var sb strings.Builder
+	ctr := g.containerStmt
+
+	g.reuseGen = true
+	defer func() { g.reuseGen = false }()
+
+	sb.WriteString(fmt.Sprintf("// UnmarshalReuse - %s\nfunc (%s *%s) UnmarshalReuse(b []byte) (err error) {\n    _, err = %s.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)\n    return\n}\n\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
+
+	sb.WriteString(fmt.Sprintf("// Nested UnmarshalReuse - %s\n", ctr.DefaultName))
+	g.writeNestedUnmarshal(&sb)
+
+	sb.WriteString(fmt.Sprintf("// Reset - %s\nfunc (%s *%s) Reset() {\n", ctr.DefaultName, ctr.PrivateName, ctr.PublicName))
+	g.ForEachCtrFields(func(_ int) {
+		sb.WriteString("    " + g.getResetStmt() + "\n")
+	})
+	sb.WriteString("}\n\n")
 	return sb.String()
 }
+
+// Returns the statement, that resets the current field to its zero value.
+// Slices and maps are emptied, so UnmarshalReuse reuses their memory, nested containers are reset as well.
+func (g *GoGen) getResetStmt() string {
+	field := g.field.PublicName
+	ctr := g.containerStmt.PrivateName
+	t := g.field.Type
+
+	switch {
+	case t.IsArray:
+		return fmt.Sprintf("%s.%s = %s.%s[:0]", ctr, field, ctr, field)
+	case t.IsMap:
+		return fmt.Sprintf("clear(%s.%s)", ctr, field)
+	case g.IsContainer(t.ExternalStructure):
+		return fmt.Sprintf("%s.%s.Reset()", ctr, field)
+	case t.IsAnExternalStructure():
+		return fmt.Sprintf("%s.%s = 0", ctr, field)
+	}
+
+	switch t.TokenType {
+	case lexer.STRING:
+		return fmt.Sprintf("%s.%s = \"\"", ctr, field)
+	case lexer.BYTES:
+		return fmt.Sprintf("%s.%s = nil", ctr, field)
+	case lexer.BOOL:
+		return fmt.Sprintf("%s.%s = false", ctr, field)
+	case lexer.TIMESTAMP:
+		return fmt.Sprintf("%s.%s = time.Time{}", ctr, field)
+	default:
+		return fmt.Sprintf("%s.%s = 0", ctr, field)
+	}
+}
+
+func (g *GoGen) GenUnmarshalPlainReuse() string {
+	var sb strings.Builder
+	ctr := g.containerStmt
+
+	g.plainGen = true
+	g.reuseGen = true
+	defer func() { g.plainGen, g.reuseGen = false, false }()
+
+	sb.WriteString(fmt.Sprintf("// UnmarshalPlainReuse - %s\n", ctr.DefaultName))
+	g.writeUnmarshalPlain(&sb)
+	return sb.String()
+}
+
+// Generates the framed variants of Size, Marshal and Unmarshal, the container is wrapped
+// in a frame with its length and checksum, see bframe.
+func (g *GoGen) GenFramed() string {
+	if !g.opts.Framed {
+		return ""
+	}
+
+	var sb strings.Builder
+	ctr := g.containerStmt
+
+	sb.WriteString(fmt.Sprintf("// SizeFramed - %s\nfunc (%s *%s) SizeFramed() int {\n    return bframe.Size(%s.Size())\n}\n\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
+
+	sb.WriteString(fmt.Sprintf("// MarshalFramed - %s\nfunc (%s *%s) MarshalFramed(b []byte) {\n    start := bframe.Reserve(0, b)\n    bframe.Finish(start, %s.NestedMarshal(start, b, 0), b)\n}\n\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
+
+	sb.WriteString(fmt.Sprintf("// UnmarshalFramed - %s\nfunc (%s *%s) UnmarshalFramed(b []byte) (err error) {\n    var body []byte\n    if _, body, err = bframe.Unmarshal(0, b); err != nil {\n        return\n    }\n    return %s.Unmarshal(body)\n}\n\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
+	return sb.String()
+}
+
+// Generates the sealed variants of Marshal and Unmarshal, the marshalled container is sealed
+// into an envelope by a benc.Sealer, for example a bseal.Keyring.
+func (g *GoGen) GenSealed() string {
+	var sb strings.Builder
+	ctr := g.containerStmt
+
+	// The plaintext is zeroed, after it got sealed
+	sb.WriteString(fmt.Sprintf("// MarshalSealed - %s\nfunc (%s *%s) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {\n    p := %s.MarshalAppend(nil)\n    b, err := s.Seal(b, p)\n    clear(p)\n    return b, err\n}\n\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
+
+	sb.WriteString(fmt.Sprintf("// UnmarshalSealed - %s\nfunc (%s *%s) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {\n    if b, err = s.Open(nil, b); err != nil {\n        return\n    }\n    return %s.Unmarshal(b)\n}\n\n",
+		ctr.DefaultName, ctr.PrivateName, ctr.PublicName, ctr.PrivateName))
+	return sb.String()
+}
+
+// Generates the view of the container, that iterates over its slice and map fields on demand,
+// straight from the marshalled container, see bstd.IterSlice and bstd.IterMap.
+func (g *GoGen) GenView() string {
+	if !g.opts.Views {
+		return ""
+	}
+
+	var sb strings.Builder
+	ctr := g.containerStmt
+
+	g.ForEachCtrFields(func(_ int) {
+		field := g.field
+		t := field.Type
+
+		var ret, call string
+		switch {
+		case isPackedBoolSlice(t), t.IsDelta, t.IsGorilla:
+			// Not a slice of elements, that can be unmarshalled on their own
+			return
+		case t.IsArray:
+			ret = fmt.Sprintf("*bstd.SliceIter[%s]", utils.BencTypeToGolang(t.ChildType))
+			call = fmt.Sprintf("bgenimpl.IterSliceField[%s](v, %d, %s)",
+				utils.BencTypeToGolang(t.ChildType), field.ID, g.getElemUnmarshalFunc(t.ChildType))
+		case t.IsMap:
+			ret = fmt.Sprintf("*bstd.MapIter[%s, %s]", utils.BencTypeToGolang(t.MapKeyType), utils.BencTypeToGolang(t.ChildType))
+			call = fmt.Sprintf("bgenimpl.IterMapField[%s, %s](v, %d, %s, %s)",
+				utils.BencTypeToGolang(t.MapKeyType), utils.BencTypeToGolang(t.ChildType), field.ID, g.getElemUnmarshalFunc(t.MapKeyType), g.getElemUnmarshalFunc(t.ChildType))
+		default:
+			return
+		}
+
+		sb.WriteString(fmt.Sprintf("// View %s - %s\nfunc (v %sView) %s(l *bstd.DecodeLimits) (%s, error) {\n    it, err := %s\n    if err != nil {\n        return nil, %s\n    }\n    return it, nil\n}\n\n",
+			field.PublicName, ctr.DefaultName, ctr.PublicName, field.PublicName, ret, call, g.getWrapFieldError()))
+	})
+
+	// No slices and maps, no view
+	if sb.Len() == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("// View - %s\n// Iterates over the slices and maps of a marshalled %s, without unmarshalling it.\ntype %sView []byte\n\n",
+		ctr.DefaultName, ctr.PublicName, ctr.PublicName) + sb.String()
+}
@@ -45,10 +45,15 @@ const (
 
 	BOOL
 	BYTE
+
+	TIMESTAMP
+	DURATION
 	// types
 
-	UNSAFE // unsafe
-	RCOPY  // return copy
+	UNSAFE  // unsafe
+	RCOPY   // return copy
+	DELTA   // delta
+	GORILLA // gorilla
 	// type attributes
 
 	OPEN_BRACKET  // [
@@ -93,11 +98,16 @@ var tokens = []string{
 	BYTE: "Byte",
 	BOOL: "Bool",
 
+	TIMESTAMP: "Time",
+	DURATION:  "Duration",
+
 	BYTES:  "Bytes",
 	STRING: "String",
 
-	RCOPY:  "ReturnCopy",
-	UNSAFE: "Unsafe",
+	RCOPY:   "ReturnCopy",
+	UNSAFE:  "Unsafe",
+	DELTA:   "Delta",
+	GORILLA: "Gorilla",
 
 	OPEN_BRACKET:  "[",
 	CLOSE_BRACKET: "]",
@@ -136,11 +146,16 @@ var keywords = map[string]Token{
 	"bool": BOOL,
 	"byte": BYTE,
 
+	"timestamp": TIMESTAMP,
+	"duration":  DURATION,
+
 	"bytes":  BYTES,
 	"string": STRING,
 
-	"unsafe": UNSAFE,
-	"rcopy":  RCOPY,
+	"unsafe":  UNSAFE,
+	"rcopy":   RCOPY,
+	"delta":   DELTA,
+	"gorilla": GORILLA,
 }
 
 func (t Token) String() string {
@@ -177,6 +192,10 @@ func (t Token) Golang() string {
 		return "[]byte"
 	case STRING:
 		return "string"
+	case TIMESTAMP:
+		return "time.Time"
+	case DURATION:
+		return "time.Duration"
 	}
 	return "invalid type"
 }
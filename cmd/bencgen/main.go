@@ -19,6 +19,9 @@ var nFlag = flag.String("file", "", "custom output filename (use '...' to replac
 var fFlag = flag.Bool("force", false, "disables the breaking-change detector")
 var lFlag = flag.String("lang", "", "the language of the code that should be generated")
 var dFlag = flag.String("import-dir", "", "comma-separated list of import directories")
+var cFlag = flag.Bool("canonical", false, "marshals map entries in sorted key order")
+var vFlag = flag.Bool("views", false, "generates views, that iterate over slices and maps without unmarshalling")
+var frFlag = flag.Bool("framed", false, "generates SizeFramed, MarshalFramed and UnmarshalFramed, see bframe")
 
 func printError(m string) {
 	errorMessage := "\n\033[1;31m[bencgen] Error:\033[0m\n"
@@ -28,7 +31,7 @@ func printError(m string) {
 }
 
 func processFile(inputFile string, outputDir string, filenamePattern string, lang codegens.GenLang, importDirs []string) {
-	generator := codegens.NewGen(lang, inputFile)
+	generator := codegens.NewGen(lang, inputFile, codegens.GenOpts{CanonicalMaps: *cFlag, Views: *vFlag, Framed: *frFlag})
 	if generator == nil {
 		printError("Unknown language provided.")
 	}
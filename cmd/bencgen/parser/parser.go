@@ -246,8 +246,26 @@ func (p *Parser) expectType() *Type {
 		}
 		return &Type{IsReturnCopy: true, TokenType: tokenType}
 
+	case p.match(lexer.DELTA):
+		p.nextToken()
+		t := p.expectType()
+		if !t.IsArray || !t.ChildType.IsInteger() {
+			p.error("`delta` can only be applied to arrays of integer types, e.g. `[]int64`")
+		}
+		t.IsDelta = true
+		return t
+
+	case p.match(lexer.GORILLA):
+		p.nextToken()
+		t := p.expectType()
+		if !t.IsArray || t.ChildType.TokenType != lexer.FLOAT64 {
+			p.error("`gorilla` can only be applied to `[]float64` types")
+		}
+		t.IsGorilla = true
+		return t
+
 	default:
-		if p.matchAny(lexer.STRING, lexer.BYTES, lexer.INT, lexer.INT16, lexer.INT32, lexer.INT64, lexer.UINT, lexer.UINT16, lexer.UINT32, lexer.UINT64, lexer.FLOAT32, lexer.FLOAT64, lexer.BYTE, lexer.BOOL) {
+		if p.matchAny(lexer.STRING, lexer.BYTES, lexer.INT, lexer.INT16, lexer.INT32, lexer.INT64, lexer.UINT, lexer.UINT16, lexer.UINT32, lexer.UINT64, lexer.FLOAT32, lexer.FLOAT64, lexer.BYTE, lexer.BOOL, lexer.TIMESTAMP, lexer.DURATION) {
 			tokenType := p.token
 			p.nextToken()
 			return &Type{TokenType: tokenType}
@@ -297,6 +315,8 @@ type (
 		ExternalStructure string `json:"ctrName"`
 		IsUnsafe          bool
 		IsReturnCopy      bool
+		IsDelta           bool
+		IsGorilla         bool
 		IsArray           bool
 		IsMap             bool
 	}
@@ -330,6 +350,18 @@ func (t *Type) IsAnExternalStructure() bool {
 	return t.ExternalStructure != ""
 }
 
+// Returns true, if the type is a integer type, e.g. `int64`, not an array, map, container or enum.
+func (t *Type) IsInteger() bool {
+	if t.IsArray || t.IsMap || t.IsAnExternalStructure() {
+		return false
+	}
+	switch t.TokenType {
+	case lexer.INT, lexer.INT16, lexer.INT32, lexer.INT64, lexer.UINT, lexer.UINT16, lexer.UINT32, lexer.UINT64:
+		return true
+	}
+	return false
+}
+
 func (t *Type) AppendUnsafeIfPresent() string {
 	if t.IsUnsafe {
 		return "Unsafe"
@@ -24,6 +24,12 @@ func BencTypeToGolang(t *parser.Type) string {
 }
 
 func formatTypeHelper(t *parser.Type, useGoFormat bool) string {
+	if t.IsDelta && !useGoFormat {
+		return "delta []" + formatTypeHelper(t.ChildType, useGoFormat)
+	}
+	if t.IsGorilla && !useGoFormat {
+		return "gorilla []" + formatTypeHelper(t.ChildType, useGoFormat)
+	}
 	if t.IsArray {
 		return "[]" + formatTypeHelper(t.ChildType, useGoFormat)
 	}
@@ -77,6 +83,8 @@ func compareTypes(t1 *parser.Type, t2 *parser.Type) bool {
 
 	return t1.IsArray == t2.IsArray &&
 		t1.IsMap == t2.IsMap &&
+		t1.IsDelta == t2.IsDelta &&
+		t1.IsGorilla == t2.IsGorilla &&
 		t1.TokenType == t2.TokenType &&
 		t1.ExternalStructure == t2.ExternalStructure &&
 		compareTypes(t1.MapKeyType, t2.MapKeyType) &&
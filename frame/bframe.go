@@ -0,0 +1,149 @@
+package bframe
+
+import (
+	"errors"
+	"hash/crc32"
+
+	"github.com/deneonet/benc"
+)
+
+// A frame wraps a marshalled message, so flipped or missing bytes are detected, before the message gets unmarshalled:
+//
+//   - 1 byte flags, the lowest bit is set, if the body is compressed, see Compressor
+//   - the byte length of the body, as a little-endian uint32
+//   - the body, the marshalled message
+//   - the CRC-32C (Castagnoli) checksum of the flags, the length and the body, as a little-endian uint32
+
+var ErrChecksumMismatch = errors.New("frame checksum mismatch")
+var ErrUnknownFlags = errors.New("frame has unknown flags")
+var ErrCompressed = errors.New("frame is compressed, unmarshal it using a Compressor")
+
+const (
+	flagCompressed byte = 1 << 0
+	knownFlags          = flagCompressed
+)
+
+const (
+	HeaderSize  int = 5
+	TrailerSize int = 4
+	// The bytes a frame adds to its body
+	Overhead int = HeaderSize + TrailerSize
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// Returns the bytes needed to frame a body of 's' bytes.
+func Size(s int) int {
+	return s + Overhead
+}
+
+// Reserves the frame header at 'n', returns the offset the body starts at.
+// Marshal the body at the returned offset, then call Finish.
+//
+// !- Panics, if 'b' is too small.
+func Reserve(n int, b []byte) int {
+	_ = b[n+HeaderSize-1]
+	b[n] = 0
+	return n + HeaderSize
+}
+
+// Writes the length of the body, that starts at 'start' and ends at 'n', into the reserved frame header,
+// as well as the checksum after the body. Returns the new offset 'n' after the frame.
+//
+// !- Panics, if 'b' is too small or the body is larger than 4 GiB.
+func Finish(start int, n int, b []byte) int {
+	l := n - start
+	if uint64(l) > 1<<32-1 {
+		panic("benc: frame body exceeds 4 GiB")
+	}
+	putUint32(b[start-4:start], uint32(l))
+	putUint32(b[n:n+TrailerSize], crc32.Checksum(b[start-HeaderSize:n], castagnoli))
+	return n + TrailerSize
+}
+
+// Appends 'body' framed to 'b' and returns the extended buffer.
+func Append(b []byte, body []byte) []byte {
+	n := len(b)
+	b = append(b, make([]byte, Size(len(body)))...)
+	start := Reserve(n, b)
+	Finish(start, start+copy(b[start:], body), b)
+	return b
+}
+
+// Returns the new offset 'n' after skipping the frame, the checksum is not verified.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'buf' was too small to skip the frame.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func Skip(n int, b []byte) (int, error) {
+	_, end, err := unmarshalHeader(n, b)
+	if err != nil {
+		return 0, err
+	}
+	return end + TrailerSize, nil
+}
+
+// Returns the new offset 'n', as well as the body of the frame, after verifying its length and checksum.
+// The body is not copied, it is a part of 'b'.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the frame, it probably got cut off.
+//   - ErrChecksumMismatch       - the checksum doesn't match the frame, it got corrupted.
+//   - ErrUnknownFlags           - the frame has flags, that aren't known.
+//   - ErrCompressed             - the body is compressed, use Compressor.Unmarshal instead.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func Unmarshal(n int, b []byte) (int, []byte, error) {
+	tn, flags, body, err := unmarshalFrame(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+	if flags&flagCompressed != 0 {
+		return 0, nil, benc.NewDecodeError(n, "frame", ErrCompressed)
+	}
+	return tn, body, nil
+}
+
+// Returns the new offset 'n', the flags and the body of the frame, after verifying its length, checksum and flags.
+func unmarshalFrame(n int, b []byte) (int, byte, []byte, error) {
+	start, end, err := unmarshalHeader(n, b)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	if crc32.Checksum(b[n:end], castagnoli) != uint32At(b[end:end+TrailerSize]) {
+		return 0, 0, nil, benc.NewDecodeError(n, "frame", ErrChecksumMismatch)
+	}
+	if b[n]&^knownFlags != 0 {
+		return 0, 0, nil, benc.NewDecodeError(n, "frame", ErrUnknownFlags)
+	}
+	return end + TrailerSize, b[n], b[start:end], nil
+}
+
+// Returns the offset the body starts at and the offset it ends at.
+func unmarshalHeader(n int, b []byte) (int, int, error) {
+	if len(b)-n < Overhead {
+		return 0, 0, benc.NewDecodeError(n, "frame", benc.ErrBufTooSmall)
+	}
+
+	start := n + HeaderSize
+	l := uint32At(b[start-4 : start])
+	if uint64(l) > uint64(len(b)-start-TrailerSize) {
+		return 0, 0, benc.NewDecodeError(n, "frame", benc.ErrBufTooSmall)
+	}
+	return start, start + int(l), nil
+}
+
+func putUint32(u []byte, v uint32) {
+	_ = u[3]
+	u[0] = byte(v)
+	u[1] = byte(v >> 8)
+	u[2] = byte(v >> 16)
+	u[3] = byte(v >> 24)
+}
+
+func uint32At(u []byte) uint32 {
+	_ = u[3]
+	return uint32(u[0]) | uint32(u[1])<<8 | uint32(u[2])<<16 | uint32(u[3])<<24
+}
@@ -0,0 +1,211 @@
+package bframe
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/deneonet/benc"
+)
+
+// A compressed frame has the lowest flag bit set, its body is the byte length of the uncompressed body,
+// as a little-endian uint32, followed by the uncompressed body, compressed with raw DEFLATE (compress/flate).
+// The frame already has a checksum, so the adler-32 checksum of zlib isn't needed.
+
+var ErrCorruptCompression = errors.New("frame has corrupt compressed data")
+
+type optFunc func(*Opts)
+
+type Opts struct {
+	threshold uint
+	level     int
+	maxSize   uint
+}
+
+func defaultOpts() Opts {
+	return Opts{
+		threshold: 512,
+		level:     flate.DefaultCompression,
+	}
+}
+
+// Bodies smaller than 'threshold' bytes are not compressed, they are not worth it.
+func WithThreshold(threshold uint) optFunc {
+	return func(o *Opts) {
+		o.threshold = threshold
+	}
+}
+
+// The compression level, from flate.HuffmanOnly to flate.BestCompression, see compress/flate.
+func WithLevel(level int) optFunc {
+	return func(o *Opts) {
+		o.level = level
+	}
+}
+
+// Compressed bodies, that are larger than 'maxSize' bytes, when uncompressed, are not unmarshalled.
+// Zero, the default, means no limit.
+func WithMaxSize(maxSize uint) optFunc {
+	return func(o *Opts) {
+		o.maxSize = maxSize
+	}
+}
+
+// Compressor frames bodies like Append does, but compresses the ones above a threshold.
+// The flate writers and readers are pooled, so it is safe, and cheap, to use it concurrently.
+type Compressor struct {
+	o       Opts
+	writers sync.Pool
+	readers sync.Pool
+}
+
+// Appends the compressed bytes to 'b'.
+type deflater struct {
+	fw *flate.Writer
+	b  []byte
+}
+
+func (d *deflater) Write(p []byte) (int, error) {
+	d.b = append(d.b, p...)
+	return len(p), nil
+}
+
+type inflater struct {
+	br  bytes.Reader
+	fr  io.ReadCloser
+	one [1]byte
+}
+
+// Returns a new compressor.
+//
+// !- Panics, if the compression level is invalid.
+func NewCompressor(opts ...optFunc) *Compressor {
+	o := defaultOpts()
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	if _, err := flate.NewWriter(io.Discard, o.level); err != nil {
+		panic("benc: " + err.Error())
+	}
+
+	c := &Compressor{o: o}
+	c.writers.New = func() interface{} {
+		d := &deflater{}
+		d.fw, _ = flate.NewWriter(d, o.level)
+		return d
+	}
+	c.readers.New = func() interface{} {
+		r := &inflater{}
+		r.fr = flate.NewReader(&r.br)
+		return r
+	}
+	return c
+}
+
+// Appends 'body' framed to 'b' and returns the extended buffer, see Append.
+// The body is compressed, if it has at least the threshold bytes and gets smaller.
+func (c *Compressor) Append(b []byte, body []byte) []byte {
+	if uint(len(body)) < c.o.threshold || uint64(len(body)) > 1<<32-1 {
+		return Append(b, body)
+	}
+
+	n := len(b)
+	start := n + HeaderSize
+	b = append(b, flagCompressed, 0, 0, 0, 0, 0, 0, 0, 0)
+	putUint32(b[start:start+4], uint32(len(body)))
+
+	// Writing to a deflater never fails
+	d := c.writers.Get().(*deflater)
+	d.b = b
+	d.fw.Reset(d)
+	d.fw.Write(body)
+	d.fw.Close()
+	b = d.b
+	d.b = nil
+	c.writers.Put(d)
+
+	if len(b)-start >= len(body) {
+		return Append(b[:n], body)
+	}
+
+	b = append(b, 0, 0, 0, 0)
+	Finish(start, len(b)-TrailerSize, b)
+	return b
+}
+
+// Marshals 's' bytes, using 'f', into a buffer leased from 'bp' and appends them framed to 'b', see Compressor.Append.
+// Only the frame is allocated, if 'b' is too small. The lease is released, after the body got framed.
+//
+// Possible errors returned:
+//   - benc.ErrReuseBufTooSmall  - the buffers of 'bp' are smaller than 's'.
+func (c *Compressor) AppendWithPool(b []byte, bp *benc.BufPool, s int, f func(b []byte) (n int)) ([]byte, error) {
+	l, err := bp.Get(s)
+	if err != nil {
+		return nil, err
+	}
+	defer l.Release()
+
+	f(l.Bytes())
+	return c.Append(b, l.Bytes()), nil
+}
+
+// Returns the new offset 'n', as well as the body of the frame, after verifying its length and checksum.
+// A compressed body is decompressed into 'dst', which is reused, if it is large enough.
+// Otherwise the body is not copied, it is a part of 'b', see Unmarshal.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the frame, it probably got cut off.
+//   - benc.ErrLimitExceeded     - the uncompressed body is larger than the maximum size, see WithMaxSize.
+//   - ErrChecksumMismatch       - the checksum doesn't match the frame, it got corrupted.
+//   - ErrUnknownFlags           - the frame has flags, that aren't known.
+//   - ErrCorruptCompression     - the compressed body is invalid or its size doesn't match.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func (c *Compressor) Unmarshal(dst []byte, n int, b []byte) (int, []byte, error) {
+	tn, flags, body, err := unmarshalFrame(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+	if flags&flagCompressed == 0 {
+		return tn, body, nil
+	}
+
+	if len(body) < 4 {
+		return 0, nil, benc.NewDecodeError(n, "frame", benc.ErrBufTooSmall)
+	}
+	s := uint(uint32At(body[:4]))
+	if c.o.maxSize > 0 && s > c.o.maxSize {
+		return 0, nil, benc.NewDecodeError(n, "frame", benc.ErrLimitExceeded)
+	}
+
+	// DEFLATE compresses 1032 bytes at most into one, so a larger size is a lie, not worth allocating
+	if s > uint(len(body))*1032 {
+		return 0, nil, benc.NewDecodeError(n, "frame", ErrCorruptCompression)
+	}
+
+	if uint(cap(dst)) < s {
+		dst = make([]byte, s)
+	}
+	dst = dst[:s]
+
+	r := c.readers.Get().(*inflater)
+	r.br.Reset(body[4:])
+	r.fr.(flate.Resetter).Reset(&r.br, nil)
+
+	_, err = io.ReadFull(r.fr, dst)
+	if err == nil {
+		// The compressed body has to end right after 's' bytes
+		if m, rerr := io.ReadFull(r.fr, r.one[:]); m != 0 || rerr != io.EOF {
+			err = ErrCorruptCompression
+		}
+	}
+	c.readers.Put(r)
+
+	if err != nil {
+		return 0, nil, benc.NewDecodeError(n, "frame", ErrCorruptCompression)
+	}
+	return tn, dst, nil
+}
@@ -0,0 +1,133 @@
+package bframe
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/deneonet/benc"
+	bstd "github.com/deneonet/benc/std"
+)
+
+func TestCompressor(t *testing.T) {
+	c := NewCompressor(WithThreshold(64))
+
+	random := make([]byte, 4096)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	for _, tt := range []struct {
+		body       []byte
+		compressed bool
+	}{
+		{[]byte{}, false},
+		{[]byte("short, below the threshold"), false},
+		{[]byte(strings.Repeat("level=info msg=\"request served\" path=/api/v1/users ", 100)), true},
+		{random, false},
+	} {
+		buf := c.Append([]byte{42}, tt.body)[1:]
+		if compressed := buf[0]&flagCompressed != 0; compressed != tt.compressed {
+			t.Fatalf("%d bytes: compressed %t, want %t", len(tt.body), compressed, tt.compressed)
+		}
+		if tt.compressed && len(buf) >= len(tt.body) {
+			t.Fatalf("%d bytes: compressed into %d bytes", len(tt.body), len(buf))
+		}
+		if !tt.compressed && !bytes.Equal(buf, Append(nil, tt.body)) {
+			t.Fatalf("%d bytes: expected a plain frame", len(tt.body))
+		}
+
+		if n, err := Skip(0, buf); err != nil || n != len(buf) {
+			t.Fatalf("skip: n %d, err %v", n, err)
+		}
+
+		n, retBody, err := c.Unmarshal(nil, 0, buf)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if n != len(buf) || !bytes.Equal(retBody, tt.body) {
+			t.Fatalf("no match: n %d\norg %v\ndec %v", n, tt.body, retBody)
+		}
+	}
+}
+
+func TestCompressorErrors(t *testing.T) {
+	c := NewCompressor(WithThreshold(0), WithLevel(flate.BestCompression))
+	body := bytes.Repeat([]byte("benc "), 200)
+	buf := c.Append(nil, body)
+
+	if _, _, err := Unmarshal(0, buf); !errors.Is(err, ErrCompressed) {
+		t.Fatalf("expected ErrCompressed, got %v", err)
+	}
+	if _, _, err := NewCompressor(WithMaxSize(999)).Unmarshal(nil, 0, buf); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatalf("expected benc.ErrLimitExceeded, got %v", err)
+	}
+
+	// A correct checksum over a wrong uncompressed size
+	for _, s := range []uint32{999, 1001, 1 << 31} {
+		wrong := bytes.Clone(buf)
+		putUint32(wrong[HeaderSize:HeaderSize+4], s)
+		Finish(HeaderSize, len(wrong)-TrailerSize, wrong)
+		if _, _, err := c.Unmarshal(nil, 0, wrong); !errors.Is(err, ErrCorruptCompression) {
+			t.Fatalf("size %d: expected ErrCorruptCompression, got %v", s, err)
+		}
+	}
+
+	// Reuses 'dst'
+	dst := make([]byte, 0, 1000)
+	_, retBody, err := c.Unmarshal(dst, 0, buf)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !bytes.Equal(retBody, body) || &retBody[0] != &dst[:1][0] {
+		t.Fatal("into dst: no match!")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for an invalid level")
+		}
+	}()
+	NewCompressor(WithLevel(42))
+}
+
+func TestCompressorWithPool(t *testing.T) {
+	c := NewCompressor()
+	bp := benc.NewBufPool(benc.WithBufferSize(4096))
+
+	s := strings.Repeat("Hello World! ", 100)
+	buf, err := c.AppendWithPool(nil, bp, bstd.SizeString(s), func(b []byte) int {
+		return bstd.MarshalString(0, b, s)
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, body, err := c.Unmarshal(nil, 0, buf)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, retS, err := bstd.UnmarshalString(0, body); err != nil || retS != s {
+		t.Fatalf("no match, err %v", err)
+	}
+
+	if _, err := c.AppendWithPool(nil, bp, 4097, func(b []byte) int { return len(b) }); !errors.Is(err, benc.ErrReuseBufTooSmall) {
+		t.Fatalf("expected benc.ErrReuseBufTooSmall, got %v", err)
+	}
+}
+
+func BenchmarkCompressor(b *testing.B) {
+	c := NewCompressor()
+	body := []byte(strings.Repeat("level=info msg=\"request served\" path=/api/v1/users ", 100))
+	buf := c.Append(nil, body)
+	dst := make([]byte, len(body))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = c.Append(buf[:0], body)
+		if _, _, err := c.Unmarshal(dst, 0, buf); err != nil {
+			b.Fatal(err.Error())
+		}
+	}
+}
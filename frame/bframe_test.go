@@ -0,0 +1,83 @@
+package bframe
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/deneonet/benc"
+	bstd "github.com/deneonet/benc/std"
+)
+
+func TestFrame(t *testing.T) {
+	for _, body := range [][]byte{{}, {1}, []byte("Hello World!"), bytes.Repeat([]byte{0xab}, 1000)} {
+		buf := make([]byte, Size(len(body)))
+		start := Reserve(0, buf)
+		if n := Finish(start, start+copy(buf[start:], body), buf); n != len(buf) {
+			t.Fatalf("n %d, want %d", n, len(buf))
+		}
+		if b := Append([]byte{42}, body); !bytes.Equal(b[1:], buf) {
+			t.Fatalf("append: no match\norg %v\ndec %v", buf, b[1:])
+		}
+
+		if n, err := Skip(0, buf); err != nil || n != len(buf) {
+			t.Fatalf("skip: n %d, err %v", n, err)
+		}
+
+		n, retBody, err := Unmarshal(0, buf)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if n != len(buf) || !bytes.Equal(retBody, body) {
+			t.Fatalf("no match: n %d\norg %v\ndec %v", n, body, retBody)
+		}
+	}
+}
+
+func TestFrameMessage(t *testing.T) {
+	s := "Hello World!"
+	buf := make([]byte, Size(bstd.SizeString(s)))
+	start := Reserve(0, buf)
+	Finish(start, bstd.MarshalString(start, buf, s), buf)
+
+	_, body, err := Unmarshal(0, buf)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, retS, err := bstd.UnmarshalString(0, body); err != nil || retS != s {
+		t.Fatalf("no match: %q, err %v", retS, err)
+	}
+}
+
+func TestFrameErrors(t *testing.T) {
+	buf := Append(nil, []byte("Hello World!"))
+
+	// Every flipped bit is detected
+	for i := range buf {
+		for bit := 0; bit < 8; bit++ {
+			buf[i] ^= 1 << bit
+			_, _, err := Unmarshal(0, buf)
+			if !errors.Is(err, ErrChecksumMismatch) && !errors.Is(err, benc.ErrBufTooSmall) {
+				t.Fatalf("byte %d, bit %d: expected ErrChecksumMismatch or benc.ErrBufTooSmall, got %v", i, bit, err)
+			}
+			buf[i] ^= 1 << bit
+		}
+	}
+
+	for i := 0; i < len(buf); i++ {
+		if _, _, err := Unmarshal(0, buf[:i]); !errors.Is(err, benc.ErrBufTooSmall) {
+			t.Fatalf("%d bytes: expected benc.ErrBufTooSmall, got %v", i, err)
+		}
+		if _, err := Skip(0, buf[:i]); !errors.Is(err, benc.ErrBufTooSmall) {
+			t.Fatalf("%d bytes: expected benc.ErrBufTooSmall, got %v", i, err)
+		}
+	}
+
+	// A correct checksum over unknown flags
+	flags := Append(nil, []byte{1, 2, 3})
+	flags[0] = 0x80
+	Finish(HeaderSize, HeaderSize+3, flags)
+	if _, _, err := Unmarshal(0, flags); !errors.Is(err, ErrUnknownFlags) {
+		t.Fatalf("expected ErrUnknownFlags, got %v", err)
+	}
+}
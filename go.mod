@@ -1,12 +1,17 @@
 retract (
-    v1.1.0 // Undefined behavior vulnerability
-    v1.1.1 // Broken varint skip
-    v1.1.2 // Undefined Uint methods after code generation
-    v1.1.5 // Broken code generation with slices and maps using imported benc schemas
+	v1.1.5 // Broken code generation with slices and maps using imported benc schemas
+	v1.1.2 // Undefined Uint methods after code generation
+	v1.1.1 // Broken varint skip
+	v1.1.0 // Undefined behavior vulnerability
 )
 
 module github.com/deneonet/benc
 
-go 1.22
+go 1.23.0
 
 require golang.org/x/exp v0.0.0-20231110203233-9a3e6036ecaa
+
+require (
+	golang.org/x/crypto v0.41.0
+	golang.org/x/sys v0.35.0 // indirect
+)
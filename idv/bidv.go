@@ -1,7 +1,9 @@
 package bidv
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	bstd "github.com/deneonet/benc/std"
 )
@@ -61,23 +63,126 @@ func GetDefaultIdNickname(id uint) string {
 	}
 }
 
-var GetIdNickname = GetDefaultIdNickname
+var ErrIdMismatch = errors.New("id mismatch")
+var ErrDuplicateId = errors.New("id already registered")
+var ErrUnknownId = errors.New("id not registered")
 
 type SkipFunc func(n int, b []byte) (int, error)
 type MarshalFunc[T any] func(n int, b []byte, t T) int
 
+type registered struct {
+	name    string
+	skipper SkipFunc
+}
+
+// Registry names IDs and knows, how to skip the value after them.
+// Each library may use its own registry, so their IDs don't collide.
+//
+// It is safe for concurrent use.
+type Registry struct {
+	mu  sync.RWMutex
+	ids map[uint]registered
+}
+
+// The registry used by Skip and Unmarshal, prefilled with the standard IDs for all data types.
+var DefaultRegistry = newDefaultRegistry()
+
+// Returns the nickname of 'id' in DefaultRegistry.
+//
+// Skip and Unmarshal name the IDs in their errors with it, so overwriting it still renames them.
+//
+// Deprecated: Use DefaultRegistry.Nickname, register nicknames with DefaultRegistry.Register.
+var GetIdNickname = func(id uint) string {
+	return DefaultRegistry.Nickname(id)
+}
+
+func newDefaultRegistry() *Registry {
+	r := NewRegistry()
+	for id, skipper := range map[uint]SkipFunc{
+		Int16: bstd.SkipInt16, Int32: bstd.SkipInt32, Int64: bstd.SkipInt64,
+		UInt16: bstd.SkipUint16, UInt32: bstd.SkipUint32, UInt64: bstd.SkipUint64,
+		Float32: bstd.SkipFloat32, Float64: bstd.SkipFloat64,
+		Bool: bstd.SkipBool, Byte: bstd.SkipByte, String: bstd.SkipString,
+		Slice: bstd.SkipSlice, Map: bstd.SkipMap, ByteSlice: bstd.SkipBytes,
+	} {
+		r.ids[id] = registered{name: GetDefaultIdNickname(id), skipper: skipper}
+	}
+	return r
+}
+
+// Returns a new, empty registry.
+func NewRegistry() *Registry {
+	return &Registry{ids: make(map[uint]registered)}
+}
+
+// Registers 'id' with the nickname 'name', used in errors, and 'skipper', which skips the value after the ID.
+// 'skipper' may be nil, then the value is only skipped, if a skipper is passed to Skip.
+//
+// Possible errors returned:
+//   - ErrDuplicateId            - 'id' was already registered.
+func (r *Registry) Register(id uint, name string, skipper SkipFunc) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.ids[id]; ok {
+		return fmt.Errorf("%w: %s (%d)", ErrDuplicateId, r.ids[id].name, id)
+	}
+	r.ids[id] = registered{name: name, skipper: skipper}
+	return nil
+}
+
+// Returns the nickname of 'id', "N/A" if it isn't registered.
+func (r *Registry) Nickname(id uint) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if reg, ok := r.ids[id]; ok {
+		return reg.name
+	}
+	return "N/A"
+}
+
+func (r *Registry) skipper(id uint) SkipFunc {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.ids[id].skipper
+}
+
+func (r *Registry) mismatch(id uint, dId uint) error {
+	nickname := r.Nickname
+	if r == DefaultRegistry {
+		nickname = GetIdNickname
+	}
+	return fmt.Errorf("%w: expected %s (%d), got %s (%d)", ErrIdMismatch, nickname(id), id, nickname(dId), dId)
+}
+
+// Skips the ID, after validating it, and the value after it, using the default registry, see SkipWith.
 func Skip(n int, b []byte, id uint, skipper SkipFunc) (int, error) {
+	return SkipWith(DefaultRegistry, n, b, id, skipper)
+}
+
+// Skips the ID, after validating it, and the value after it, using 'skipper'.
+// If 'skipper' is nil, the skipper registered for 'id' in 'r' is used.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'buf' was too small to skip the ID or the value.
+//   - ErrIdMismatch             - the ID isn't 'id', it is named by 'r'.
+//   - ErrUnknownId              - 'skipper' is nil and 'r' has no skipper for 'id'.
+func SkipWith(r *Registry, n int, b []byte, id uint, skipper SkipFunc) (int, error) {
 	n, dId, err := bstd.UnmarshalUint(n, b)
 	if err != nil {
 		return 0, err
 	}
 
 	if dId != id {
-		nn := GetIdNickname(id)
-		dNn := GetIdNickname(dId)
-		return 0, fmt.Errorf("id mismatch: expected %s (%d), got %s (%d)", nn, id, dNn, dId)
+		return 0, r.mismatch(id, dId)
 	}
 
+	if skipper == nil {
+		if skipper = r.skipper(id); skipper == nil {
+			return 0, fmt.Errorf("%w: no skipper for %d", ErrUnknownId, id)
+		}
+	}
 	return skipper(n, b)
 }
 
@@ -89,7 +194,21 @@ func Marshal(n int, b []byte, id uint) int {
 	return bstd.MarshalUint(n, b, id)
 }
 
+// Unmarshals the ID, after validating it, and the value after it, using the default registry, see UnmarshalWith.
 func Unmarshal[T any](tn int, b []byte, id uint, unmarshaler any) (n int, t T, err error) {
+	return UnmarshalWith[T](DefaultRegistry, tn, b, id, unmarshaler)
+}
+
+// Unmarshals the ID, after validating it, and the value after it, using 'unmarshaler', which is either
+// a `func(n int, b []byte) (int, T, error)` or a `func(n int, b []byte, v *T) (int, error)`.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the ID.
+//   - ErrIdMismatch             - the ID isn't 'id', it is named by 'r'.
+//   - every error, 'unmarshaler' returns.
+//
+// !- Panics, if 'unmarshaler' is neither of both.
+func UnmarshalWith[T any](r *Registry, tn int, b []byte, id uint, unmarshaler any) (n int, t T, err error) {
 	n, dId, err := bstd.UnmarshalUint(tn, b)
 	if err != nil {
 		n = 0
@@ -98,9 +217,7 @@ func Unmarshal[T any](tn int, b []byte, id uint, unmarshaler any) (n int, t T, e
 
 	if dId != id {
 		n = 0
-		nn := GetIdNickname(id)
-		dNn := GetIdNickname(dId)
-		err = fmt.Errorf("id mismatch: expected %s (%d), got %s (%d)", nn, id, dNn, dId)
+		err = r.mismatch(id, dId)
 		return
 	}
 
@@ -1,7 +1,10 @@
 package bidv
 
 import (
+	"errors"
+	"fmt"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/deneonet/benc"
@@ -84,12 +87,12 @@ func TestStruct(t *testing.T) {
 func TestErrBufTooSmall(t *testing.T) {
 	var id uint = 10
 	_, err := Skip(0, []byte{}, id, bstd.SkipString)
-	if err != benc.ErrBufTooSmall {
+	if !errors.Is(err, benc.ErrBufTooSmall) {
 		t.Fatal(err)
 	}
 
 	_, _, err = Unmarshal[string](0, []byte{}, id, bstd.UnmarshalString)
-	if err != benc.ErrBufTooSmall {
+	if !errors.Is(err, benc.ErrBufTooSmall) {
 		t.Fatal(err)
 	}
 }
@@ -119,6 +122,23 @@ func TestErrIdMismatch(t *testing.T) {
 	}
 }
 
+func TestGetIdNicknameOverwritten(t *testing.T) {
+	defer func(getIdNickname func(id uint) string) { GetIdNickname = getIdNickname }(GetIdNickname)
+	GetIdNickname = func(id uint) string {
+		return fmt.Sprint("ID", id)
+	}
+
+	b := make([]byte, Size(10, bstd.SizeString("Hello World!")))
+	bstd.MarshalString(Marshal(0, b, 10), b, "Hello World!")
+
+	if _, err := Skip(0, b, 9, bstd.SkipString); err == nil || err.Error() != "id mismatch: expected ID9 (9), got ID10 (10)" {
+		t.Fatalf("skip: expected the overwritten nicknames, got %v", err)
+	}
+	if _, _, err := Unmarshal[string](0, b, 9, bstd.UnmarshalString); err == nil || err.Error() != "id mismatch: expected ID9 (9), got ID10 (10)" {
+		t.Fatalf("unmarshal: expected the overwritten nicknames, got %v", err)
+	}
+}
+
 func TestDefaultIdNicknames(t *testing.T) {
 	_ = GetDefaultIdNickname(2)
 	_ = GetDefaultIdNickname(3)
@@ -136,3 +156,93 @@ func TestDefaultIdNicknames(t *testing.T) {
 	_ = GetDefaultIdNickname(15)
 	_ = GetDefaultIdNickname(16)
 }
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(TestId, "Test", func(n int, b []byte) (int, error) {
+		return bstd.SkipString(n, b)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register(TestId, "Other", nil); !errors.Is(err, ErrDuplicateId) {
+		t.Fatalf("expected ErrDuplicateId, got %v", err)
+	}
+	if err := r.Register(TestId+1, "Unskippable", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	data := Test{err: "None"}
+	b := make([]byte, Size(TestId, data.Size()))
+	data.Marshal(Marshal(0, b, TestId), b)
+
+	// The registered skipper is used
+	if n, err := SkipWith(r, 0, b, TestId, nil); err != nil || n != len(b) {
+		t.Fatalf("skip: n %d, err %v", n, err)
+	}
+
+	_, err := SkipWith(r, 0, b, TestId+1, nil)
+	if !errors.Is(err, ErrIdMismatch) || err.Error() != "id mismatch: expected Unskippable (17), got Test (16)" {
+		t.Fatalf("expected a named ErrIdMismatch, got %v", err)
+	}
+	_, _, err = UnmarshalWith[string](r, 0, b, TestId+1, bstd.UnmarshalString)
+	if !errors.Is(err, ErrIdMismatch) {
+		t.Fatalf("expected ErrIdMismatch, got %v", err)
+	}
+
+	b = make([]byte, Size(TestId+1, 0))
+	Marshal(0, b, TestId+1)
+	if _, err = SkipWith(r, 0, b, TestId+1, nil); !errors.Is(err, ErrUnknownId) {
+		t.Fatalf("expected ErrUnknownId, got %v", err)
+	}
+
+	// Registries are independent of each other
+	if DefaultRegistry.Nickname(TestId) != "N/A" || r.Nickname(String) != "N/A" {
+		t.Fatal("expected the registries to be independent")
+	}
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	for id := Int16; id <= ByteSlice; id++ {
+		if DefaultRegistry.Nickname(id) != GetDefaultIdNickname(id) {
+			t.Fatalf("%d: expected nickname %s, got %s", id, GetDefaultIdNickname(id), DefaultRegistry.Nickname(id))
+		}
+		if GetIdNickname(id) != DefaultRegistry.Nickname(id) {
+			t.Fatalf("%d: expected the deprecated GetIdNickname to read from the default registry", id)
+		}
+		if err := DefaultRegistry.Register(id, "Other", nil); !errors.Is(err, ErrDuplicateId) {
+			t.Fatalf("%d: expected ErrDuplicateId, got %v", id, err)
+		}
+	}
+
+	str := "Hello World!"
+	b := make([]byte, Size(String, bstd.SizeString(str)))
+	bstd.MarshalString(Marshal(0, b, String), b, str)
+	if n, err := Skip(0, b, String, nil); err != nil || n != len(b) {
+		t.Fatalf("skip: n %d, err %v", n, err)
+	}
+}
+
+// Run with -race
+func TestRegistryConcurrent(t *testing.T) {
+	r := NewRegistry()
+
+	var wg sync.WaitGroup
+	for g := uint(0); g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := uint(0); i < 100; i++ {
+				id := AllowedStartId + g*100 + i
+				if err := r.Register(id, "Concurrent", bstd.SkipString); err != nil {
+					t.Error(err)
+					return
+				}
+				if r.Nickname(id) != "Concurrent" || r.Nickname(id+1000) != "N/A" {
+					t.Errorf("%d: unexpected nickname", id)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
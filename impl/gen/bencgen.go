@@ -3,6 +3,7 @@ package bgenimpl
 import (
 	"errors"
 	"slices"
+	"strconv"
 
 	"github.com/deneonet/benc"
 	bstd "github.com/deneonet/benc/std"
@@ -20,6 +21,8 @@ const (
 	Fixed32
 	Fixed64
 	ArrayMap
+	Fixed128
+	PackedBools
 )
 
 func skipByType(tn int, b []byte, t byte) (n int, err error) {
@@ -30,69 +33,122 @@ func skipByType(tn int, b []byte, t byte) (n int, err error) {
 	case ArrayMap:
 		n, err = bstd.SkipSlice(n, b)
 	case Varint:
-		n, err = bstd.SkipVarint(n, b)
+		n, err = bstd.SkipVarint64(n, b)
 	case Container:
-		for b[n] != 1 || b[n+1] != 1 {
-			n, _, t, err = UnmarshalTag(n, b)
-			if err != nil {
-				return
-			}
-
-			n, err = skipByType(n, b, t)
-			if err != nil {
-				return
-			}
-		}
-		n += 2
+		n, err = SkipContainerEnd(n, b)
 	case Fixed8:
-		n += 1
+		n, err = skipFixed(n, b, 1)
 	case Fixed16:
-		n += 2
+		n, err = skipFixed(n, b, 2)
 	case Fixed32:
-		n += 4
+		n, err = skipFixed(n, b, 4)
 	case Fixed64:
-		n += 8
+		n, err = skipFixed(n, b, 8)
+	case Fixed128:
+		n, err = skipFixed(n, b, 16)
+	case PackedBools:
+		n, err = bstd.SkipBoolSlice(n, b)
 	default:
-		err = ErrInvalidType
+		err = benc.NewDecodeError(tn, "field", ErrInvalidType)
 	}
 	return
 }
 
+func skipFixed(n int, b []byte, s int) (int, error) {
+	if len(b)-n < s {
+		return 0, benc.NewDecodeError(n, "field", benc.ErrBufTooSmall)
+	}
+	return n + s, nil
+}
+
+// Returns the new offset 'n' after the end of the container, the fields before the end are skipped.
+// Used by generated code, after the known fields of a container got unmarshalled, so fields added
+// by a newer schema are skipped as well.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'buf' was too small to skip to the end of the container.
+//   - ErrInvalidType            - a field has a type, that isn't known.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func SkipContainerEnd(n int, b []byte) (int, error) {
+	for {
+		if len(b)-n < 2 {
+			return 0, benc.NewDecodeError(n, "container", benc.ErrBufTooSmall)
+		}
+		if b[n] == 1 && b[n+1] == 1 {
+			return n + 2, nil
+		}
+
+		var t byte
+		var err error
+		if n, _, t, err = UnmarshalTag(n, b); err != nil {
+			return 0, err
+		}
+		if n, err = skipByType(n, b, t); err != nil {
+			return 0, err
+		}
+	}
+}
+
 func HandleCompatibility(n int, b []byte, r []uint16, id uint16) (int, bool, error) {
+	n, _, ok, err := HandleCompatibilityType(n, b, r, id)
+	return n, ok, err
+}
+
+// Like HandleCompatibility, but also returns the type in the tag of the field, if it is present.
+// Used by generated code for fields, that were marshalled in a different format by an older bencgen, see UnmarshalBoolSliceField.
+func HandleCompatibilityType(n int, b []byte, r []uint16, id uint16) (int, byte, bool, error) {
 	n, tId, typ, err := UnmarshalTag(n, b)
 	if err != nil {
-		return 0, false, ErrEof
+		return 0, 0, false, ErrEof
 	}
 
 	for tId != id {
 		if slices.Contains(r, tId) {
 			n, err = skipByType(n, b, typ)
 			if err != nil {
-				return 0, false, err
+				return 0, 0, false, err
 			}
 
 			n, tId, typ, err = UnmarshalTag(n, b)
 			if err != nil {
-				return 0, false, ErrEof
+				return 0, 0, false, ErrEof
 			}
 
 			continue
 		}
 
 		if tId > 255 {
-			return n - 3, false, nil
+			return n - 3, 0, false, nil
 		}
 
-		return n - 2, false, nil
+		return n - 2, 0, false, nil
 	}
 
-	return n, true, nil
+	return n, typ, true, nil
+}
+
+// Returns the new offset 'n', as well as the bool slice field, that got unmarshalled into 'dst'.
+// The type 't' in the tag of the field decides the format: PackedBools is unmarshalled by bstd.UnmarshalBoolSliceIntoLimited,
+// ArrayMap, written by an older bencgen, by bstd.UnmarshalSliceIntoLimited, as the bools aren't packed.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the bool slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalBoolSliceField(l *bstd.DecodeLimits, t byte, n int, b []byte, dst []bool) (int, []bool, error) {
+	if t == ArrayMap {
+		return bstd.UnmarshalSliceIntoLimited(l, n, b, dst, bstd.ToUnmarshalIntoFunc(bstd.UnmarshalBool))
+	}
+	return bstd.UnmarshalBoolSliceIntoLimited(l, n, b, dst)
 }
 
 func SkipTag(n int, b []byte) (int, error) {
 	lb := len(b)
 	if lb-n < 2 {
-		return 0, benc.ErrBufTooSmall
+		return 0, benc.NewDecodeError(n, "tag", benc.ErrBufTooSmall)
 	}
 
 	l := b[n]&0x80 != 0
@@ -100,7 +156,7 @@ func SkipTag(n int, b []byte) (int, error) {
 
 	if l {
 		if lb-n < 1 {
-			return 0, benc.ErrBufTooSmall
+			return 0, benc.NewDecodeError(n-2, "tag", benc.ErrBufTooSmall)
 		}
 
 		return n + 1, nil
@@ -127,10 +183,23 @@ func MarshalTag(n int, b []byte, t byte, id uint16) int {
 	return n + 1
 }
 
+func AppendTag(b []byte, t byte, id uint16) []byte {
+	var c uint8
+	if id > 255 {
+		c |= 0x80
+	}
+
+	b = append(b, c|t&0x7F)
+	if id > 255 {
+		return append(b, byte(id>>8), byte(id&0xFF))
+	}
+	return append(b, byte(id))
+}
+
 func UnmarshalTag(n int, b []byte) (int, uint16, byte, error) {
 	lb := len(b)
 	if lb-n < 2 {
-		return 0, 0, 0, benc.ErrBufTooSmall
+		return 0, 0, 0, benc.NewDecodeError(n, "tag", benc.ErrBufTooSmall)
 	}
 
 	l := b[n]&0x80 != 0
@@ -139,15 +208,21 @@ func UnmarshalTag(n int, b []byte) (int, uint16, byte, error) {
 
 	if l {
 		if lb-n < 1 {
-			return 0, 0, 0, benc.ErrBufTooSmall
+			return 0, 0, 0, benc.NewDecodeError(n-2, "tag", benc.ErrBufTooSmall)
 		}
 		return n + 1, uint16(b[n-1])<<8 | uint16(b[n]), typ, nil
 	}
 	return n, uint16(b[n-1]), typ, nil
 }
 
+// Prepends the field 'field' ('id') of the container 'ctr' to the path of 'err', see benc.WithDecodePath.
+// Used by generated code, for errors returned while unmarshalling a field.
+func WrapFieldError(err error, ctr string, field string, id uint16) error {
+	return benc.WithDecodePath(err, ctr+"."+field+"("+strconv.Itoa(int(id))+")")
+}
+
 func SkipEnum(n int, b []byte) (int, error) {
-	return bstd.SkipVarint(n, b)
+	return bstd.SkipVarint64(n, b)
 }
 
 func SizeEnum[T ~int](v T) int {
@@ -158,7 +233,84 @@ func MarshalEnum[T ~int](n int, b []byte, v T) int {
 	return bstd.MarshalInt(n, b, int(v))
 }
 
+func AppendEnum[T ~int](b []byte, v T) []byte {
+	return bstd.AppendInt(b, int(v))
+}
+
 func UnmarshalEnum[T ~int](n int, b []byte) (int, T, error) {
 	n, v, err := bstd.UnmarshalInt(n, b)
 	return n, T(v), err
 }
+
+// Returns the offset after the tag of the field 'id', as well as its type, in the container, whose tag is at 'n'.
+// Returns false, if the container has no field 'id'.
+func FindField(n int, b []byte, id uint16) (int, byte, bool, error) {
+	tn := n
+	n, _, t, err := UnmarshalTag(n, b)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if t != Container {
+		return 0, 0, false, benc.NewDecodeError(tn, "container", ErrInvalidType)
+	}
+
+	for {
+		if len(b)-n < 2 {
+			return 0, 0, false, benc.NewDecodeError(n, "container", benc.ErrBufTooSmall)
+		}
+		if b[n] == 1 && b[n+1] == 1 {
+			return 0, 0, false, nil
+		}
+
+		var tId uint16
+		n, tId, t, err = UnmarshalTag(n, b)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		if tId == id {
+			return n, t, true, nil
+		}
+
+		n, err = skipByType(n, b, t)
+		if err != nil {
+			return 0, 0, false, err
+		}
+	}
+}
+
+// Returns the offset of the slice or map field 'id', in the marshalled container 'b'.
+// Returns false, if the container has no field 'id'.
+func findArrayMapField(b []byte, id uint16) (int, bool, error) {
+	n, t, ok, err := FindField(0, b, id)
+	if !ok {
+		return 0, false, err
+	}
+	if t != ArrayMap {
+		return 0, false, benc.NewDecodeError(n, "field", ErrInvalidType)
+	}
+	return n, true, nil
+}
+
+// Returns a iterator over the elements of the slice field 'id', in the marshalled container 'b', see bstd.IterSlice.
+// Used by generated views, a missing field results in a nil (empty) iterator.
+func IterSliceField[T any](b []byte, id uint16, unmarshaler bstd.UnmarshalFunc[T]) (*bstd.SliceIter[T], error) {
+	n, ok, err := findArrayMapField(b, id)
+	if !ok {
+		return nil, err
+	}
+
+	_, it, err := bstd.IterSlice(n, b, unmarshaler)
+	return it, err
+}
+
+// Returns a iterator over the entries of the map field 'id', in the marshalled container 'b', see bstd.IterMap.
+// Used by generated views, a missing field results in a nil (empty) iterator.
+func IterMapField[K comparable, V any](b []byte, id uint16, kUnmarshaler bstd.UnmarshalFunc[K], vUnmarshaler bstd.UnmarshalFunc[V]) (*bstd.MapIter[K, V], error) {
+	n, ok, err := findArrayMapField(b, id)
+	if !ok {
+		return nil, err
+	}
+
+	_, it, err := bstd.IterMap(n, b, kUnmarshaler, vUnmarshaler)
+	return it, err
+}
@@ -3,7 +3,7 @@ package bgenimpl
 import (
 	"encoding/binary"
 	"errors"
-	"strconv"
+	"slices"
 	"testing"
 
 	"github.com/deneonet/benc"
@@ -47,22 +47,22 @@ func TestTags(t *testing.T) {
 	}
 
 	_, _, _, err = UnmarshalTag(0, []byte{})
-	if err != benc.ErrBufTooSmall {
+	if !errors.Is(err, benc.ErrBufTooSmall) {
 		t.Fatal("expected ErrBufTooSmall")
 	}
 
 	_, _, _, err = UnmarshalTag(0, []byte{1})
-	if err != benc.ErrBufTooSmall {
+	if !errors.Is(err, benc.ErrBufTooSmall) {
 		t.Fatal("2: expected ErrBufTooSmall")
 	}
 
 	_, err = SkipTag(0, []byte{})
-	if err != benc.ErrBufTooSmall {
+	if !errors.Is(err, benc.ErrBufTooSmall) {
 		t.Fatal("3: expected ErrBufTooSmall")
 	}
 
 	_, err = SkipTag(0, []byte{1})
-	if err != benc.ErrBufTooSmall {
+	if !errors.Is(err, benc.ErrBufTooSmall) {
 		t.Fatal("4: expected ErrBufTooSmall")
 	}
 
@@ -96,12 +96,12 @@ func TestTags(t *testing.T) {
 	ts[1] = buf[1]
 
 	_, err = SkipTag(0, ts)
-	if err != benc.ErrBufTooSmall {
+	if !errors.Is(err, benc.ErrBufTooSmall) {
 		t.Fatal("expected benc.ErrBufTooSmall")
 	}
 
 	_, _, _, err = UnmarshalTag(0, ts)
-	if err != benc.ErrBufTooSmall {
+	if !errors.Is(err, benc.ErrBufTooSmall) {
 		t.Fatal("2: expected benc.ErrBufTooSmall")
 	}
 }
@@ -156,50 +156,40 @@ func TestHandleCompatibility_Basic(t *testing.T) {
 }
 
 func TestHandleCompatibility_Types(t *testing.T) {
-	buf := make([]byte, 2)
-	MarshalTag(0, buf, Fixed8, 1)
-
-	_, ok, err := HandleCompatibility(0, buf, []uint16{1}, 0)
-	if ok {
-		t.Fatal("unexpected `ok`")
-	}
-	if err != ErrEof {
-		t.Fatal("expected ErrEof")
-	}
-
-	MarshalTag(0, buf, Fixed16, 1)
-	_, ok, err = HandleCompatibility(0, buf, []uint16{1}, 0)
-	if ok {
-		t.Fatal("unexpected `ok`")
-	}
-	if err != ErrEof {
-		t.Fatal("expected ErrEof")
-	}
-
-	MarshalTag(0, buf, Fixed32, 1)
-	_, ok, err = HandleCompatibility(0, buf, []uint16{1}, 0)
-	if ok {
-		t.Fatal("unexpected `ok`")
-	}
-	if err != ErrEof {
-		t.Fatal("expected ErrEof")
-	}
-
-	MarshalTag(0, buf, Fixed64, 1)
-	_, ok, err = HandleCompatibility(0, buf, []uint16{1}, 0)
-	if ok {
-		t.Fatal("unexpected `ok`")
-	}
-	if err != ErrEof {
-		t.Fatal("expected ErrEof")
+	for _, tt := range []struct {
+		typ  byte
+		size int
+	}{{Fixed8, 1}, {Fixed16, 2}, {Fixed32, 4}, {Fixed64, 8}, {Fixed128, 16}} {
+		buf := make([]byte, 2+tt.size)
+		MarshalTag(0, buf, tt.typ, 1)
+
+		_, ok, err := HandleCompatibility(0, buf, []uint16{1}, 0)
+		if ok {
+			t.Fatal("unexpected `ok`")
+		}
+		if err != ErrEof {
+			t.Fatal("expected ErrEof")
+		}
+
+		// The value is cut off
+		_, ok, err = HandleCompatibility(0, buf[:len(buf)-1], []uint16{1}, 0)
+		if ok {
+			t.Fatal("unexpected `ok`")
+		}
+		if !errors.Is(err, benc.ErrBufTooSmall) {
+			t.Fatal("expected benc.ErrBufTooSmall")
+		}
 	}
 
+	buf := make([]byte, 2)
+	var ok bool
+	var err error
 	MarshalTag(0, buf, Varint, 1)
 	_, ok, err = HandleCompatibility(0, buf, []uint16{1}, 0)
 	if ok {
 		t.Fatal("unexpected `ok`")
 	}
-	if err != benc.ErrBufTooSmall {
+	if !errors.Is(err, benc.ErrBufTooSmall) {
 		t.Fatal("expected ErrBufTooSmall")
 	}
 
@@ -226,7 +216,7 @@ func TestHandleCompatibility_Types(t *testing.T) {
 	if ok {
 		t.Fatal("unexpected `ok`")
 	}
-	if err != ErrInvalidType {
+	if !errors.Is(err, ErrInvalidType) {
 		t.Fatal("expected ErrInvalidType")
 	}
 
@@ -238,7 +228,7 @@ func TestHandleCompatibility_Types(t *testing.T) {
 	if ok {
 		t.Fatal("unexpected `ok`")
 	}
-	if err != benc.ErrBufTooSmall {
+	if !errors.Is(err, benc.ErrBufTooSmall) {
 		t.Fatal("expected benc.ErrBufTooSmall")
 	}
 
@@ -268,7 +258,7 @@ func TestHandleCompatibility_Types(t *testing.T) {
 		t.Fatal("expected ErrEof")
 	}
 
-	buf = make([]byte, 2+4+1+2)
+	buf = make([]byte, 2+6+1+2)
 	MarshalTag(0, buf, ArrayMap, 1)
 	bstd.MarshalSlice(2, buf, []byte{1, 2}, bstd.MarshalByte)
 
@@ -280,6 +270,27 @@ func TestHandleCompatibility_Types(t *testing.T) {
 		t.Fatal("expected ErrEof")
 	}
 
+	bools := []bool{true, false, true, true, false, false, false, false, true}
+	buf = make([]byte, 2+bstd.SizeBoolSlice(bools))
+	MarshalTag(0, buf, PackedBools, 1)
+	bstd.MarshalBoolSlice(2, buf, bools)
+
+	_, ok, err = HandleCompatibility(0, buf, []uint16{1}, 0)
+	if ok {
+		t.Fatal("unexpected `ok`")
+	}
+	if err != ErrEof {
+		t.Fatal("expected ErrEof")
+	}
+
+	_, ok, err = HandleCompatibility(0, buf[:len(buf)-1], []uint16{1}, 0)
+	if ok {
+		t.Fatal("unexpected `ok`")
+	}
+	if !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatal("expected benc.ErrBufTooSmall")
+	}
+
 	buf = make([]byte, 2+2+1)
 	MarshalTag(0, buf, Fixed8, 1)
 	MarshalTag(3, buf, Fixed16, 2)
@@ -297,17 +308,13 @@ func TestHandleCompatibility_Types(t *testing.T) {
 	if ok {
 		t.Fatal("unexpected `ok`")
 	}
-	if err != ErrInvalidType {
+	if !errors.Is(err, ErrInvalidType) {
 		t.Fatal("expected ErrInvalidType")
 	}
 }
 
-var maxVarintLenMap = map[int]int{
-	64: binary.MaxVarintLen64,
-	32: binary.MaxVarintLen32,
-}
-
-var maxVarintLen = maxVarintLenMap[strconv.IntSize]
+// Enums are marshalled as 64-bit varints on every architecture.
+const maxVarintLen = binary.MaxVarintLen64
 
 func TestEnums(t *testing.T) {
 	v := 150
@@ -369,3 +376,129 @@ func TestEnums(t *testing.T) {
 		t.Errorf("expected ErrOverflow, got %v", err)
 	}
 }
+
+func TestHandleCompatibility_ArrayMapTerminatorBytes(t *testing.T) {
+	slice := []uint32{0x01010101}
+	buf := make([]byte, 2+bstd.SizeFixedSlice(slice, bstd.SizeUint32())+2+1)
+	n := MarshalTag(0, buf, ArrayMap, 1)
+	n = bstd.MarshalSlice(n, buf, slice, bstd.MarshalUint32)
+	n = MarshalTag(n, buf, Fixed8, 2)
+	buf[n] = 42
+
+	n, ok, err := HandleCompatibility(0, buf, []uint16{1}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("expected `ok`")
+	}
+	if buf[n] != 42 {
+		t.Fatalf("skipped to the wrong offset %d", n)
+	}
+
+	// v1 array, marshalled without the collection header
+	buf = []byte{ArrayMap, 1, 1, 5, 1, 1, 1, 1, Fixed8, 2, 42}
+
+	n, ok, err = HandleCompatibility(0, buf, []uint16{1}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("2: expected `ok`")
+	}
+	if n != 10 {
+		t.Fatalf("2: skipped to the wrong offset %d", n)
+	}
+}
+
+func TestUnmarshalBoolSliceField(t *testing.T) {
+	bools := []bool{true, false, true}
+
+	packed := AppendTag(nil, PackedBools, 1)
+	packed = bstd.AppendBoolSlice(packed, bools)
+	generic := AppendTag(nil, ArrayMap, 1)
+	generic = bstd.AppendSlice(generic, bools, bstd.AppendBool)
+	// v1 array, marshalled without the collection header, would be read as packed bools
+	legacy := []byte{ArrayMap, 1, 3, 1, 0, 1, 1, 1, 1, 1}
+
+	for i, buf := range [][]byte{packed, generic, legacy} {
+		n, typ, ok, err := HandleCompatibilityType(0, buf, []uint16{}, 1)
+		if err != nil || !ok {
+			t.Fatalf("%d: expected `ok`, got %v", i, err)
+		}
+		if typ != buf[0] {
+			t.Fatalf("%d: expected type %d, got %d", i, buf[0], typ)
+		}
+
+		n, ret, err := UnmarshalBoolSliceField(nil, typ, n, buf, nil)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if n != len(buf) || !slices.Equal(ret, bools) {
+			t.Fatalf("%d: unexpected %v at offset %d", i, ret, n)
+		}
+	}
+}
+
+func TestFindField(t *testing.T) {
+	slice := []uint32{0x01010101, 7}
+	buf := make([]byte, 2+2+1+2+bstd.SizeFixedSlice(slice, bstd.SizeUint32())+2)
+	n := MarshalTag(0, buf, Container, 0)
+	n = MarshalTag(n, buf, Fixed8, 1)
+	n++
+	n = MarshalTag(n, buf, ArrayMap, 2)
+	n = bstd.MarshalSlice(n, buf, slice, bstd.MarshalUint32)
+	buf[n], buf[n+1] = 1, 1
+
+	it, err := IterSliceField(buf, 2, bstd.UnmarshalUint32)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var retSlice []uint32
+	for v := range it.Values() {
+		retSlice = append(retSlice, v)
+	}
+	if it.Err() != nil || len(retSlice) != 2 || retSlice[1] != 7 {
+		t.Fatalf("no match: %v, %v", retSlice, it.Err())
+	}
+
+	// A missing field results in a nil iterator
+	if it, err = IterSliceField(buf, 3, bstd.UnmarshalUint32); it != nil || err != nil {
+		t.Fatalf("expected a nil iterator, got %v, %v", it, err)
+	}
+
+	if _, err = IterMapField(buf, 1, bstd.UnmarshalUint32, bstd.UnmarshalUint32); !errors.Is(err, ErrInvalidType) {
+		t.Fatalf("expected ErrInvalidType, got %v", err)
+	}
+	if _, _, _, err = FindField(0, buf[:n], 3); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected ErrBufTooSmall, got %v", err)
+	}
+	if _, _, _, err = FindField(2, buf, 3); !errors.Is(err, ErrInvalidType) {
+		t.Fatalf("expected ErrInvalidType, got %v", err)
+	}
+}
+
+func FuzzSkip(f *testing.F) {
+	b := AppendTag(nil, Varint, 1)
+	b = bstd.AppendUint(b, 300)
+	b = AppendTag(b, Bytes, 2)
+	b = bstd.AppendString(b, "Hello World!")
+	b = AppendTag(b, Container, 3)
+	b = append(AppendTag(b, Fixed32, 1), 1, 2, 3, 4, 1, 1)
+	f.Add(append(b, 1, 1))
+	f.Add([]byte{1, 1})
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		if n, err := SkipContainerEnd(0, b); err == nil && n > len(b) {
+			t.Fatalf("skip: offset %d out of [0, %d]", n, len(b))
+		}
+		c := append(AppendTag(nil, Container, 1), b...)
+		if n, _, ok, err := FindField(0, c, 3); err == nil && ok && n > len(c) {
+			t.Fatalf("find: offset %d out of [0, %d]", n, len(c))
+		}
+		if n, _, err := HandleCompatibility(0, b, []uint16{2}, 3); err == nil && n > len(b) {
+			t.Fatalf("compatibility: offset %d out of [0, %d]", n, len(b))
+		}
+	})
+}
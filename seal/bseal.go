@@ -0,0 +1,217 @@
+package bseal
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"sync"
+
+	"github.com/deneonet/benc"
+	bstd "github.com/deneonet/benc/std"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// An envelope is self-describing, everything but the key is stored in it:
+//
+//   - 1 byte algorithm, see Algorithm
+//   - the key ID, as a uint32 varint
+//   - the nonce, 12 bytes for both algorithms
+//   - the ciphertext, followed by the 16 bytes authentication tag
+//
+// The algorithm, the key ID and the nonce are authenticated as well, as additional data.
+
+var ErrUnknownAlgorithm = errors.New("unknown seal algorithm")
+var ErrAlgorithmMismatch = errors.New("envelope algorithm doesn't match the key")
+var ErrUnknownKey = errors.New("unknown seal key id")
+var ErrDuplicateKey = errors.New("seal key id already added")
+var ErrInvalidKey = errors.New("invalid seal key size")
+var ErrNoPrimaryKey = errors.New("no primary seal key set")
+var ErrOpen = errors.New("envelope authentication failed")
+
+type Algorithm byte
+
+const (
+	// AES-256 in Galois/Counter Mode, needs a 32 bytes key
+	AES256GCM Algorithm = iota + 1
+	// ChaCha20-Poly1305, needs a 32 bytes key, faster than AES-GCM without AES hardware support
+	ChaCha20Poly1305
+)
+
+const (
+	NonceSize int = 12
+	TagSize   int = 16
+)
+
+func (a Algorithm) String() string {
+	switch a {
+	case AES256GCM:
+		return "AES-256-GCM"
+	case ChaCha20Poly1305:
+		return "ChaCha20-Poly1305"
+	default:
+		return "N/A"
+	}
+}
+
+func newAEAD(alg Algorithm, key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, ErrInvalidKey
+	}
+
+	switch alg {
+	case AES256GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	case ChaCha20Poly1305:
+		return chacha20poly1305.New(key)
+	default:
+		return nil, ErrUnknownAlgorithm
+	}
+}
+
+// Sealer seals marshalled messages into envelopes and opens them again, for example a Keyring.
+// It's declared in package benc, so generated containers take one, without importing bseal.
+type Sealer = benc.Sealer
+
+type key struct {
+	alg  Algorithm
+	aead cipher.AEAD
+}
+
+// Keyring is a Sealer, which seals with its primary key and opens envelopes with the key, their key ID refers to.
+// Rotate keys by adding a new key and making it the primary one, envelopes sealed with the old keys
+// are still opened, until they are removed.
+//
+// It is safe for concurrent use.
+type Keyring struct {
+	mu         sync.RWMutex
+	keys       map[uint32]key
+	primary    uint32
+	hasPrimary bool
+}
+
+// Returns a new, empty keyring.
+func NewKeyring() *Keyring {
+	return &Keyring{keys: make(map[uint32]key)}
+}
+
+// Adds the key 'secret', used with 'alg', as 'id'.
+//
+// Possible errors returned:
+//   - ErrDuplicateKey           - a key with 'id' was already added.
+//   - ErrInvalidKey             - 'secret' isn't 32 bytes long.
+//   - ErrUnknownAlgorithm       - 'alg' is not known.
+func (k *Keyring) Add(id uint32, alg Algorithm, secret []byte) error {
+	aead, err := newAEAD(alg, secret)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if _, ok := k.keys[id]; ok {
+		return ErrDuplicateKey
+	}
+	k.keys[id] = key{alg: alg, aead: aead}
+	return nil
+}
+
+// Makes the key 'id' the one, new envelopes are sealed with.
+//
+// Possible errors returned:
+//   - ErrUnknownKey             - no key with 'id' was added.
+func (k *Keyring) SetPrimary(id uint32) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if _, ok := k.keys[id]; !ok {
+		return ErrUnknownKey
+	}
+	k.primary, k.hasPrimary = id, true
+	return nil
+}
+
+// Removes the key 'id', envelopes sealed with it can't be opened anymore.
+// If it is the primary key, there is no primary key afterwards.
+func (k *Keyring) Remove(id uint32) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	delete(k.keys, id)
+	if k.primary == id {
+		k.hasPrimary = false
+	}
+}
+
+// Appends the envelope of 'plaintext', sealed with the primary key and a random nonce, to 'dst'
+// and returns the extended buffer.
+//
+// Possible errors returned:
+//   - ErrNoPrimaryKey           - no primary key is set, see SetPrimary.
+func (k *Keyring) Seal(dst []byte, plaintext []byte) ([]byte, error) {
+	k.mu.RLock()
+	id, kk, ok := k.primary, k.keys[k.primary], k.hasPrimary
+	k.mu.RUnlock()
+
+	if !ok {
+		return nil, ErrNoPrimaryKey
+	}
+
+	n := len(dst)
+	dst = append(dst, byte(kk.alg))
+	dst = bstd.AppendUvarint32(dst, id)
+
+	dst = append(dst, make([]byte, NonceSize)...)
+	nonce := dst[len(dst)-NonceSize:]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return kk.aead.Seal(dst, nonce, plaintext, dst[n:]), nil
+}
+
+// Appends the plaintext of 'envelope' to 'dst' and returns the extended buffer,
+// after looking up the key by its key ID and authenticating the envelope.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'envelope' is too small, it probably got cut off.
+//   - benc.ErrOverflow          - the key ID overflowed a 32-bit unsigned integer.
+//   - ErrUnknownKey             - there is no key for the key ID of the envelope.
+//   - ErrAlgorithmMismatch      - the key is used with an other algorithm.
+//   - ErrOpen                   - the envelope got corrupted or was sealed with an other key.
+func (k *Keyring) Open(dst []byte, envelope []byte) ([]byte, error) {
+	if len(envelope) < 1 {
+		return nil, benc.NewDecodeError(0, "envelope", benc.ErrBufTooSmall)
+	}
+	alg := Algorithm(envelope[0])
+
+	n, id, err := bstd.UnmarshalUvarint32(1, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	k.mu.RLock()
+	kk, ok := k.keys[id]
+	k.mu.RUnlock()
+
+	if !ok {
+		return nil, benc.NewDecodeError(1, "envelope", ErrUnknownKey)
+	}
+	if kk.alg != alg {
+		return nil, benc.NewDecodeError(0, "envelope", ErrAlgorithmMismatch)
+	}
+	if len(envelope)-n < NonceSize+TagSize {
+		return nil, benc.NewDecodeError(n, "envelope", benc.ErrBufTooSmall)
+	}
+
+	nonce := envelope[n : n+NonceSize]
+	dst, err = kk.aead.Open(dst, nonce, envelope[n+NonceSize:], envelope[:n+NonceSize])
+	if err != nil {
+		return nil, benc.NewDecodeError(0, "envelope", ErrOpen)
+	}
+	return dst, nil
+}
@@ -0,0 +1,168 @@
+package bseal
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/deneonet/benc"
+)
+
+func newKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+func TestSeal(t *testing.T) {
+	for _, alg := range []Algorithm{AES256GCM, ChaCha20Poly1305} {
+		k := NewKeyring()
+		if err := k.Add(300, alg, newKey(1)); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := k.SetPrimary(300); err != nil {
+			t.Fatal(err.Error())
+		}
+
+		for _, plaintext := range [][]byte{{}, []byte("Hello World!"), bytes.Repeat([]byte{0xab}, 1000)} {
+			envelope, err := k.Seal([]byte{42}, plaintext)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			envelope = envelope[1:]
+			if Algorithm(envelope[0]) != alg || len(envelope) != 1+2+NonceSize+len(plaintext)+TagSize {
+				t.Fatalf("%s: unexpected envelope %v", alg, envelope)
+			}
+
+			retPlaintext, err := k.Open([]byte{42}, envelope)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if !bytes.Equal(retPlaintext[1:], plaintext) {
+				t.Fatalf("%s: no match\norg %v\ndec %v", alg, plaintext, retPlaintext[1:])
+			}
+		}
+
+		// Random nonces
+		a, _ := k.Seal(nil, []byte("same"))
+		b, _ := k.Seal(nil, []byte("same"))
+		if bytes.Equal(a, b) {
+			t.Fatalf("%s: sealed the same plaintext into the same envelope twice", alg)
+		}
+	}
+}
+
+func TestSealRotation(t *testing.T) {
+	k := NewKeyring()
+	if err := k.Add(1, AES256GCM, newKey(1)); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := k.Add(2, ChaCha20Poly1305, newKey(2)); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	k.SetPrimary(1)
+	old, _ := k.Seal(nil, []byte("old"))
+	k.SetPrimary(2)
+	cur, _ := k.Seal(nil, []byte("new"))
+
+	// Both keys open their envelopes
+	if p, err := k.Open(nil, old); err != nil || string(p) != "old" {
+		t.Fatalf("old: %q, err %v", p, err)
+	}
+	if p, err := k.Open(nil, cur); err != nil || string(p) != "new" {
+		t.Fatalf("new: %q, err %v", p, err)
+	}
+
+	k.Remove(1)
+	if _, err := k.Open(nil, old); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("expected ErrUnknownKey, got %v", err)
+	}
+	k.Remove(2)
+	if _, err := k.Seal(nil, []byte("new")); !errors.Is(err, ErrNoPrimaryKey) {
+		t.Fatalf("expected ErrNoPrimaryKey, got %v", err)
+	}
+}
+
+func TestSealErrors(t *testing.T) {
+	k := NewKeyring()
+	if _, err := k.Seal(nil, nil); !errors.Is(err, ErrNoPrimaryKey) {
+		t.Fatalf("expected ErrNoPrimaryKey, got %v", err)
+	}
+	if err := k.SetPrimary(1); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("expected ErrUnknownKey, got %v", err)
+	}
+	if err := k.Add(1, AES256GCM, newKey(1)[:16]); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected ErrInvalidKey, got %v", err)
+	}
+	if err := k.Add(1, Algorithm(42), newKey(1)); !errors.Is(err, ErrUnknownAlgorithm) {
+		t.Fatalf("expected ErrUnknownAlgorithm, got %v", err)
+	}
+
+	k.Add(1, AES256GCM, newKey(1))
+	if err := k.Add(1, ChaCha20Poly1305, newKey(2)); !errors.Is(err, ErrDuplicateKey) {
+		t.Fatalf("expected ErrDuplicateKey, got %v", err)
+	}
+	k.SetPrimary(1)
+	envelope, _ := k.Seal(nil, []byte("Hello World!"))
+
+	// Every flipped bit is detected
+	for i := range envelope {
+		for bit := 0; bit < 8; bit++ {
+			envelope[i] ^= 1 << bit
+			if _, err := k.Open(nil, envelope); err == nil {
+				t.Fatalf("byte %d, bit %d: expected an error", i, bit)
+			}
+			envelope[i] ^= 1 << bit
+		}
+	}
+
+	for i := 0; i < len(envelope); i++ {
+		if _, err := k.Open(nil, envelope[:i]); err == nil {
+			t.Fatalf("%d bytes: expected an error", i)
+		}
+	}
+	if _, err := k.Open(nil, envelope[:1+1+NonceSize]); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+
+	wrongAlg := bytes.Clone(envelope)
+	wrongAlg[0] = byte(ChaCha20Poly1305)
+	if _, err := k.Open(nil, wrongAlg); !errors.Is(err, ErrAlgorithmMismatch) {
+		t.Fatalf("expected ErrAlgorithmMismatch, got %v", err)
+	}
+
+	// The same key ID, but an other key
+	other := NewKeyring()
+	other.Add(1, AES256GCM, newKey(2))
+	if _, err := other.Open(nil, envelope); !errors.Is(err, ErrOpen) {
+		t.Fatalf("expected ErrOpen, got %v", err)
+	}
+}
+
+func TestSealConcurrent(t *testing.T) {
+	k := NewKeyring()
+	k.Add(0, AES256GCM, newKey(0))
+	k.SetPrimary(0)
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			k.Add(uint32(i), ChaCha20Poly1305, newKey(byte(i)))
+			k.SetPrimary(uint32(i))
+		}()
+		go func() {
+			defer wg.Done()
+			envelope, err := k.Seal(nil, []byte("Hello World!"))
+			if err != nil {
+				t.Error(err.Error())
+				return
+			}
+			if _, err := k.Open(nil, envelope); err != nil {
+				t.Error(err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -1,9 +1,7 @@
 package bstd
 
 import (
-	"encoding/binary"
 	"math"
-	"strconv"
 	"unsafe"
 
 	"github.com/deneonet/benc"
@@ -12,6 +10,73 @@ import (
 
 type SizeFunc[T any] func(t T) int
 type MarshalFunc[T any] func(n int, b []byte, t T) int
+type UnmarshalFunc[T any] func(n int, b []byte) (int, T, error)
+type UnmarshalIntoFunc[T any] func(n int, b []byte, t *T) (int, error)
+
+// Returns a SizeFunc, that returns 's' for every value, for example `bstd.FixedSize[int32](bstd.SizeInt32())`.
+func FixedSize[T any](s int) SizeFunc[T] {
+	return func(T) int {
+		return s
+	}
+}
+
+// Returns 'u' as a UnmarshalIntoFunc, that stores the unmarshalled value in 't'.
+func ToUnmarshalIntoFunc[T any](u UnmarshalFunc[T]) UnmarshalIntoFunc[T] {
+	return func(n int, b []byte, t *T) (n2 int, err error) {
+		n2, *t, err = u(n, b)
+		return
+	}
+}
+
+// Returns 'u' as a UnmarshalFunc, that unmarshals into a new value, for example a container in a map.
+func ToUnmarshalFunc[T any](u UnmarshalIntoFunc[T]) UnmarshalFunc[T] {
+	return func(n int, b []byte) (n2 int, t T, err error) {
+		n2, err = u(n, b, &t)
+		return
+	}
+}
+
+// Returns the UnmarshalIntoFunc, the untyped unmarshaler 'u' (the argument 'arg' of 'fn') is.
+//
+// !- Panics, if 'u' isn't a UnmarshalFunc or UnmarshalIntoFunc.
+func toUnmarshalIntoFunc[T any](u interface{}, arg string, fn string) UnmarshalIntoFunc[T] {
+	switch p := u.(type) {
+	case func(n int, b []byte) (int, T, error):
+		return ToUnmarshalIntoFunc(p)
+	case func(n int, b []byte, t *T) (int, error):
+		return p
+	default:
+		panic("benc: invalid `" + arg + "` provided in `" + fn + "`")
+	}
+}
+
+// Returns the UnmarshalFunc, the untyped unmarshaler 'u' (the argument 'arg' of 'fn') is.
+//
+// !- Panics, if 'u' isn't a UnmarshalFunc or UnmarshalIntoFunc.
+func toUnmarshalFunc[T any](u interface{}, arg string, fn string) UnmarshalFunc[T] {
+	switch p := u.(type) {
+	case func(n int, b []byte) (int, T, error):
+		return p
+	case func(n int, b []byte, t *T) (int, error):
+		return ToUnmarshalFunc(p)
+	default:
+		panic("benc: invalid `" + arg + "` provided in `" + fn + "`")
+	}
+}
+
+// Returns the SizeFunc, the untyped sizer 's' (the argument 'arg' of SizeMap) is.
+//
+// !- Panics, if 's' isn't a `func() int` or a SizeFunc.
+func toSizeFunc[T any](s interface{}, arg string) SizeFunc[T] {
+	switch p := s.(type) {
+	case func() int:
+		return FixedSize[T](p())
+	case func(T) int:
+		return p
+	default:
+		panic("benc: invalid `" + arg + "` provided in `SizeMap`")
+	}
+}
 
 // Returns the new offset 'n' after skipping the marshalled string.
 // For unsafe string unmarshalling too.
@@ -28,8 +93,8 @@ func SkipString(n int, b []byte) (int, error) {
 	}
 	s := int(us)
 
-	if len(b)-n < s {
-		return n, benc.ErrBufTooSmall
+	if us > uint(len(b)-n) {
+		return n, benc.NewDecodeError(n, "string", benc.ErrBufTooSmall)
 	}
 	return n + s, nil
 }
@@ -63,8 +128,8 @@ func UnmarshalString(n int, b []byte) (int, string, error) {
 	}
 	s := int(us)
 
-	if len(b)-n < s {
-		return n, "", benc.ErrBufTooSmall
+	if us > uint(len(b)-n) {
+		return n, "", benc.NewDecodeError(n, "string", benc.ErrBufTooSmall)
 	}
 	return n + s, string(b[n : n+s]), nil
 }
@@ -128,24 +193,98 @@ func UnmarshalUnsafeString(n int, b []byte) (int, string, error) {
 		return n, "", nil
 	}
 
-	if len(b)-n < s {
-		return n, "", benc.ErrBufTooSmall
+	if us > uint(len(b)-n) {
+		return n, "", benc.NewDecodeError(n, "string", benc.ErrBufTooSmall)
 	}
 	return n + s, b2s(b[n : n+s]), nil
 }
 
-// Returns the new offset 'n' after skipping the marshalled slice.
+// Slices and maps are prefixed with a collection header:
 //
-// Possible errors returned:
-//   - benc.ErrBufTooSmall       - 'buf' was too small to skip the marshalled slice.
+//	0x80 0x00 | uint32 (little endian) byte length of the body | body
 //
-// If a error is returned, n (the int returned) equals zero ( 0 ).
-func SkipSlice(n int, b []byte) (int, error) {
+// The body is the varint element count, followed by the elements.
+//
+// `0x80 0x00` is a non-minimal varint encoding of zero, which is never
+// written by `MarshalUint`. Collections marshalled by benc versions prior to
+// the header (v1: varint count, elements, `0x01 0x01 0x01 0x01` terminator)
+// always start with a minimal varint, so both formats can be told apart and
+// v1 payloads can still be skipped and unmarshalled.
+const (
+	collectionMarker0    byte = 0x80
+	collectionMarker1    byte = 0x00
+	collectionHeaderSize int  = 6
+)
+
+// Returns true, if a collection header starts at 'n'.
+func hasCollectionHeader(n int, b []byte) bool {
+	return len(b)-n >= 2 && b[n] == collectionMarker0 && b[n+1] == collectionMarker1
+}
+
+// Returns the new offset 'n' after the collection header, as well as the offset, the collection ends at.
+func unmarshalCollectionHeader(n int, b []byte) (int, int, error) {
+	if len(b)-n < collectionHeaderSize {
+		return 0, 0, benc.NewDecodeError(n, "collection header", benc.ErrBufTooSmall)
+	}
+	u := b[n+2 : n+6]
+	_ = u[3]
+	l := uint64(u[0]) | uint64(u[1])<<8 | uint64(u[2])<<16 | uint64(u[3])<<24
+	n += collectionHeaderSize
+
+	// Compared before converting, as the length doesn't fit into a 32-bit int
+	if l > uint64(len(b)-n) {
+		return 0, 0, benc.NewDecodeError(n, "collection header", benc.ErrBufTooSmall)
+	}
+	return n, n + int(l), nil
+}
+
+// Reserves the collection header at 'n', returns the offset the body starts at.
+//
+// !- Panics, if 'b' is too small.
+func reserveCollectionHeader(n int, b []byte) int {
+	u := b[n : n+collectionHeaderSize]
+	_ = u[5]
+	u[0] = collectionMarker0
+	u[1] = collectionMarker1
+	return n + collectionHeaderSize
+}
+
+// Writes the byte length of the body, that starts at 'start' and ends at 'n', into the reserved collection header.
+//
+// !- Panics, if the body is larger than 4 GiB.
+func finishCollectionHeader(start int, n int, b []byte) int {
+	l := n - start
+	if uint64(l) > math.MaxUint32 {
+		panic("benc: slice or map body exceeds 4 GiB")
+	}
+	u := b[start-4 : start]
+	_ = u[3]
+	u[0] = byte(l)
+	u[1] = byte(l >> 8)
+	u[2] = byte(l >> 16)
+	u[3] = byte(l >> 24)
+	return n
+}
+
+// Returns the new offset 'n' after the terminator of a v1 collection 't', that ends at 'n'.
+func skipLegacyTerminator[T any](n int, b []byte, t T) (int, T, error) {
+	if len(b)-n < 4 {
+		var zero T
+		return 0, zero, benc.NewDecodeError(n, "collection terminator", benc.ErrBufTooSmall)
+	}
+	return n + 4, t, nil
+}
+
+// Returns the new offset 'n' after skipping a collection, marshalled without the collection header (v1).
+//
+// A v1 collection has no length, so it is skipped by scanning for its terminator.
+// Elements containing the terminator bytes cut the skip short, which is why v1 was replaced.
+func skipLegacyCollection(n int, b []byte) (int, error) {
 	lb := len(b)
 
 	for {
 		if lb-n < 4 {
-			return 0, benc.ErrBufTooSmall
+			return 0, benc.NewDecodeError(n, "collection", benc.ErrBufTooSmall)
 		}
 
 		if b[n] == 1 && b[n+1] == 1 && b[n+2] == 1 && b[n+3] == 1 {
@@ -155,10 +294,34 @@ func SkipSlice(n int, b []byte) (int, error) {
 	}
 }
 
+// Returns the new offset 'n' after skipping the marshalled slice or map.
+func skipCollection(n int, b []byte) (int, error) {
+	if !hasCollectionHeader(n, b) {
+		return skipLegacyCollection(n, b)
+	}
+
+	_, end, err := unmarshalCollectionHeader(n, b)
+	if err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+// Returns the new offset 'n' after skipping the marshalled slice.
+// Slices marshalled without the collection header (v1) are skipped as well.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'buf' was too small to skip the marshalled slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func SkipSlice(n int, b []byte) (int, error) {
+	return skipCollection(n, b)
+}
+
 // Returns the bytes needed to marshal a slice with a dynamic element size.
 func SizeSlice[T any](slice []T, sizer SizeFunc[T]) (s int) {
 	v := len(slice)
-	s += 4 + SizeUint(uint(v))
+	s += collectionHeaderSize + SizeUint(uint(v))
 
 	for _, t := range slice {
 		s += sizer(t)
@@ -170,7 +333,7 @@ func SizeSlice[T any](slice []T, sizer SizeFunc[T]) (s int) {
 // Returns the bytes needed to marshal a slice with a fixed element size.
 func SizeFixedSlice[T any](slice []T, elemSize int) (s int) {
 	v := len(slice)
-	s += 4 + SizeUint(uint(v)) + v*elemSize
+	s += collectionHeaderSize + SizeUint(uint(v)) + v*elemSize
 	return
 }
 
@@ -178,104 +341,199 @@ func SizeFixedSlice[T any](slice []T, elemSize int) (s int) {
 //
 // !- Panics, if 'b' is too small.
 func MarshalSlice[T any](n int, b []byte, slice []T, marshaler MarshalFunc[T]) int {
-	n = MarshalUint(n, b, uint(len(slice)))
+	start := reserveCollectionHeader(n, b)
+	n = MarshalUint(start, b, uint(len(slice)))
 	for _, t := range slice {
 		n = marshaler(n, b, t)
 	}
-
-	u := b[n : n+4]
-	_ = u[3]
-	u[0] = byte(1)
-	u[1] = byte(1)
-	u[2] = byte(1)
-	u[3] = byte(1)
-	return n + 4
+	return finishCollectionHeader(start, n, b)
 }
 
 // Returns the new offset 'n', as well as the slice, that got unmarshalled.
+// 'unmarshaler' is a `func(n int, b []byte) (int, T, error)` or a `func(n int, b []byte, t *T) (int, error)`,
+// see UnmarshalSliceFunc and UnmarshalSliceInto for the type-safe variants.
 //
 // Possible errors returned:
 //   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
 //   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
 //
 // If a error is returned, n (the int returned) equals zero ( 0 ).
+//
+// !- Panics, if 'unmarshaler' has none of the types above.
 func UnmarshalSlice[T any](n int, b []byte, unmarshaler interface{}) (int, []T, error) {
-	n, us, err := UnmarshalUint(n, b)
+	return UnmarshalSliceLimited[T](nil, n, b, unmarshaler)
+}
+
+// Returns the new offset 'n', as well as the slice, that got unmarshalled, see UnmarshalSlice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+//
+// !- Panics, if 'unmarshaler' has none of the types of UnmarshalSlice.
+func UnmarshalSliceLimited[T any](l *DecodeLimits, n int, b []byte, unmarshaler interface{}) (int, []T, error) {
+	return unmarshalSliceInto(l, n, b, nil, toUnmarshalIntoFunc[T](unmarshaler, "unmarshaler", "UnmarshalSlice"))
+}
+
+// Returns the new offset 'n', as well as the slice, that got unmarshalled, using 'unmarshaler' for every element.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalSliceFunc[T any](n int, b []byte, unmarshaler UnmarshalFunc[T]) (int, []T, error) {
+	return UnmarshalSliceFuncLimited(nil, n, b, unmarshaler)
+}
+
+// Returns the new offset 'n', as well as the slice, that got unmarshalled, see UnmarshalSliceFunc.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalSliceFuncLimited[T any](l *DecodeLimits, n int, b []byte, unmarshaler UnmarshalFunc[T]) (int, []T, error) {
+	return unmarshalSliceInto(l, n, b, nil, ToUnmarshalIntoFunc(unmarshaler))
+}
+
+// Returns the new offset 'n', as well as the slice, that got unmarshalled into 'dst', using 'unmarshaler' for every element.
+// The elements are unmarshalled in place, the capacity of 'dst' is reused, if it is large enough, otherwise
+// a new slice is allocated. A nil 'dst' always allocates a new slice.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalSliceInto[T any](n int, b []byte, dst []T, unmarshaler UnmarshalIntoFunc[T]) (int, []T, error) {
+	return UnmarshalSliceIntoLimited(nil, n, b, dst, unmarshaler)
+}
+
+// Returns the new offset 'n', as well as the slice, that got unmarshalled into 'dst', see UnmarshalSliceInto.
+// A new slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalSliceIntoLimited[T any](l *DecodeLimits, n int, b []byte, dst []T, unmarshaler UnmarshalIntoFunc[T]) (int, []T, error) {
+	return unmarshalSliceInto(l, n, b, dst, unmarshaler)
+}
+
+func unmarshalSliceInto[T any](l *DecodeLimits, n int, b []byte, dst []T, unmarshaler UnmarshalIntoFunc[T]) (int, []T, error) {
+	if !hasCollectionHeader(n, b) {
+		return unmarshalSlice(l, n, b, dst, unmarshaler, true)
+	}
+
+	n, end, err := unmarshalCollectionHeader(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	_, ts, err := unmarshalSlice(l, n, b[:end], dst, unmarshaler, false)
 	if err != nil {
 		return 0, nil, err
 	}
+	return end, ts, nil
+}
+
+// Returns the bits in 'l' bytes, capped at the largest uint, so it doesn't overflow on 32-bit platforms.
+func bitsIn(l int) uint {
+	if uint(l) > math.MaxUint/8 {
+		return math.MaxUint
+	}
+	return uint(l) * 8
+}
+
+// Returns 'dst' resized to 'us' elements, if its capacity is large enough, otherwise a new slice.
+// The slice ('n' is its offset) is only allocated, if it stays within the limits 'l'
+// and 'us' doesn't exceed 'maxLen', the most elements the bytes left can hold.
+func sliceInto[T any](l *DecodeLimits, n int, us uint, maxLen uint, dst []T) ([]T, error) {
 	s := int(us)
+	if dst != nil && us <= uint(cap(dst)) {
+		if err := l.allocSlice(us, 0); err != nil {
+			return nil, benc.NewDecodeError(n, "slice", err)
+		}
+		if us > maxLen {
+			return nil, benc.NewDecodeError(n, "slice", benc.ErrBufTooSmall)
+		}
+		if s < len(dst) {
+			clear(dst[s:])
+		}
+		return dst[:s], nil
+	}
 
 	var t T
-	ts := make([]T, s)
+	if err := l.allocSlice(us, unsafe.Sizeof(t)); err != nil {
+		return nil, benc.NewDecodeError(n, "slice", err)
+	}
+	if us > maxLen {
+		return nil, benc.NewDecodeError(n, "slice", benc.ErrBufTooSmall)
+	}
+	return make([]T, s), nil
+}
 
-	switch p := unmarshaler.(type) {
-	case func(n int, b []byte) (int, T, error):
-		for i := 0; i < s; i++ {
-			n, t, err = p(n, b)
-			if err != nil {
-				return 0, nil, err
-			}
+// Unmarshals the element count and the elements of a slice into 'dst', legacy skips the v1 terminator.
+func unmarshalSlice[T any](l *DecodeLimits, n int, b []byte, dst []T, unmarshaler UnmarshalIntoFunc[T], legacy bool) (int, []T, error) {
+	n, us, err := UnmarshalUint(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
 
-			ts[i] = t
-		}
-	case func(n int, b []byte, v *T) (int, error):
-		for i := 0; i < s; i++ {
-			n, err = p(n, b, &ts[i])
-			if err != nil {
-				return 0, nil, err
-			}
+	// Every element needs at least one byte
+	ts, err := sliceInto(l, n, us, uint(len(b)-n), dst)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for i := range ts {
+		n, err = unmarshaler(n, b, &ts[i])
+		if err != nil {
+			return 0, nil, err
 		}
-	default:
-		panic("benc: invalid `unmarshaler` provided in `UnmarshalSlice`")
 	}
 
-	return n + 4, ts, nil
+	if legacy {
+		return skipLegacyTerminator(n, b, ts)
+	}
+	return n, ts, nil
 }
 
 // Returns the new offset 'n' after skipping the marshalled map.
+// Maps marshalled without the collection header (v1) are skipped as well.
 //
 // Possible errors returned:
 //   - benc.ErrBufTooSmall       - 'buf' was too small to skip the marshalled map.
 //
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipMap(n int, b []byte) (int, error) {
-	lb := len(b)
-
-	for {
-		if lb-n < 4 {
-			return 0, benc.ErrBufTooSmall
-		}
-
-		if b[n] == 1 && b[n+1] == 1 && b[n+2] == 1 && b[n+3] == 1 {
-			return n + 4, nil
-		}
-		n++
-	}
+	return skipCollection(n, b)
 }
 
 // Returns the bytes needed to marshal a map.
+// 'kSizer' and 'vSizer' are a `func() int` or a `func(t T) int`, see SizeMapFunc for the type-safe variant.
+//
+// !- Panics, if 'kSizer' or 'vSizer' has none of the types above.
 func SizeMap[K comparable, V any](m map[K]V, kSizer interface{}, vSizer interface{}) (s int) {
-	s += 4 + SizeUint(uint(len(m)))
+	return SizeMapFunc(m, toSizeFunc[K](kSizer, "kSizer"), toSizeFunc[V](vSizer, "vSizer"))
+}
 
-	for k, v := range m {
-		switch p := kSizer.(type) {
-		case func() int:
-			s += p()
-		case func(K) int:
-			s += p(k)
-		default:
-			panic("benc: invalid `kSizer` provided in `SizeMap`")
-		}
+// Returns the bytes needed to marshal a map, using 'kSizer' and 'vSizer' for every entry.
+// Use FixedSize for keys or values with a fixed size.
+func SizeMapFunc[K comparable, V any](m map[K]V, kSizer SizeFunc[K], vSizer SizeFunc[V]) (s int) {
+	s += collectionHeaderSize + SizeUint(uint(len(m)))
 
-		switch p := vSizer.(type) {
-		case func() int:
-			s += p()
-		case func(V) int:
-			s += p(v)
-		default:
-			panic("benc: invalid `vSizer` provided in `SizeMap`")
-		}
+	for k, v := range m {
+		s += kSizer(k) + vSizer(v)
 	}
 	return
 }
@@ -284,29 +542,115 @@ func SizeMap[K comparable, V any](m map[K]V, kSizer interface{}, vSizer interfac
 //
 // !- Panics, if 'b' is too small.
 func MarshalMap[K comparable, V any](n int, b []byte, m map[K]V, kMarshaler MarshalFunc[K], vMarshaler MarshalFunc[V]) int {
-	n = MarshalUint(n, b, uint(len(m)))
+	start := reserveCollectionHeader(n, b)
+	n = MarshalUint(start, b, uint(len(m)))
 	for k, v := range m {
 		n = kMarshaler(n, b, k)
 		n = vMarshaler(n, b, v)
 	}
-
-	u := b[n : n+4]
-	_ = u[3]
-	u[0] = byte(1)
-	u[1] = byte(1)
-	u[2] = byte(1)
-	u[3] = byte(1)
-	return n + 4
+	return finishCollectionHeader(start, n, b)
 }
 
 // Returns the new offset 'n', as well as the map, that got unmarshalled.
+// 'kUnmarshaler' and 'vUnmarshaler' are a `func(n int, b []byte) (int, T, error)` or a `func(n int, b []byte, t *T) (int, error)`,
+// see UnmarshalMapFunc for the type-safe variant.
 //
 // Possible errors returned:
 //   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
 //   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the map.
 //
 // If a error is returned, n (the int returned) equals zero ( 0 ).
+//
+// !- Panics, if 'kUnmarshaler' or 'vUnmarshaler' has none of the types above.
 func UnmarshalMap[K comparable, V any](n int, b []byte, kUnmarshaler interface{}, vUnmarshaler interface{}) (int, map[K]V, error) {
+	return UnmarshalMapLimited[K, V](nil, n, b, kUnmarshaler, vUnmarshaler)
+}
+
+// Returns the new offset 'n', as well as the map, that got unmarshalled, see UnmarshalMap.
+// The map is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the map.
+//   - benc.ErrLimitExceeded     - the map exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+//
+// !- Panics, if 'kUnmarshaler' or 'vUnmarshaler' has none of the types of UnmarshalMap.
+func UnmarshalMapLimited[K comparable, V any](l *DecodeLimits, n int, b []byte, kUnmarshaler interface{}, vUnmarshaler interface{}) (int, map[K]V, error) {
+	return UnmarshalMapFuncLimited(l, n, b,
+		toUnmarshalFunc[K](kUnmarshaler, "kUnmarshaler", "UnmarshalMap"),
+		toUnmarshalFunc[V](vUnmarshaler, "vUnmarshaler", "UnmarshalMap"))
+}
+
+// Returns the new offset 'n', as well as the map, that got unmarshalled, using 'kUnmarshaler' and 'vUnmarshaler' for every entry.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the map.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalMapFunc[K comparable, V any](n int, b []byte, kUnmarshaler UnmarshalFunc[K], vUnmarshaler UnmarshalFunc[V]) (int, map[K]V, error) {
+	return UnmarshalMapFuncLimited(nil, n, b, kUnmarshaler, vUnmarshaler)
+}
+
+// Returns the new offset 'n', as well as the map, that got unmarshalled, see UnmarshalMapFunc.
+// The map is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the map.
+//   - benc.ErrLimitExceeded     - the map exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalMapFuncLimited[K comparable, V any](l *DecodeLimits, n int, b []byte, kUnmarshaler UnmarshalFunc[K], vUnmarshaler UnmarshalFunc[V]) (int, map[K]V, error) {
+	return unmarshalMapInto(l, n, b, nil, kUnmarshaler, vUnmarshaler)
+}
+
+// Returns the new offset 'n', as well as the map, that got unmarshalled into 'dst', using 'kUnmarshaler' and 'vUnmarshaler' for every entry.
+// The old entries of 'dst' are removed, but its memory is reused. A nil 'dst' always allocates a new map.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the map.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ) and 'dst' may hold a part of the entries.
+func UnmarshalMapInto[K comparable, V any](n int, b []byte, dst map[K]V, kUnmarshaler UnmarshalFunc[K], vUnmarshaler UnmarshalFunc[V]) (int, map[K]V, error) {
+	return UnmarshalMapIntoLimited(nil, n, b, dst, kUnmarshaler, vUnmarshaler)
+}
+
+// Returns the new offset 'n', as well as the map, that got unmarshalled into 'dst', see UnmarshalMapInto.
+// A new map is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the map.
+//   - benc.ErrLimitExceeded     - the map exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ) and 'dst' may hold a part of the entries.
+func UnmarshalMapIntoLimited[K comparable, V any](l *DecodeLimits, n int, b []byte, dst map[K]V, kUnmarshaler UnmarshalFunc[K], vUnmarshaler UnmarshalFunc[V]) (int, map[K]V, error) {
+	return unmarshalMapInto(l, n, b, dst, kUnmarshaler, vUnmarshaler)
+}
+
+func unmarshalMapInto[K comparable, V any](l *DecodeLimits, n int, b []byte, dst map[K]V, kUnmarshaler UnmarshalFunc[K], vUnmarshaler UnmarshalFunc[V]) (int, map[K]V, error) {
+	if !hasCollectionHeader(n, b) {
+		return unmarshalMap(l, n, b, dst, kUnmarshaler, vUnmarshaler, true)
+	}
+
+	n, end, err := unmarshalCollectionHeader(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	_, ts, err := unmarshalMap(l, n, b[:end], dst, kUnmarshaler, vUnmarshaler, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	return end, ts, nil
+}
+
+// Unmarshals the entry count and the entries of a map into 'dst', legacy skips the v1 terminator.
+func unmarshalMap[K comparable, V any](l *DecodeLimits, n int, b []byte, dst map[K]V, kUnmarshaler UnmarshalFunc[K], vUnmarshaler UnmarshalFunc[V], legacy bool) (int, map[K]V, error) {
 	n, us, err := UnmarshalUint(n, b)
 	if err != nil {
 		return 0, nil, err
@@ -315,43 +659,38 @@ func UnmarshalMap[K comparable, V any](n int, b []byte, kUnmarshaler interface{}
 
 	var k K
 	var v V
-	ts := make(map[K]V, s)
+	// A reused map may still grow, so it is counted like a new one.
+	if err = l.allocMap(us, unsafe.Sizeof(k)+unsafe.Sizeof(v)); err != nil {
+		return 0, nil, benc.NewDecodeError(n, "map", err)
+	}
+	// Every entry needs at least two bytes
+	if us > uint(len(b)-n)/2 {
+		return 0, nil, benc.NewDecodeError(n, "map", benc.ErrBufTooSmall)
+	}
+
+	ts := dst
+	if ts != nil {
+		clear(ts)
+	} else {
+		ts = make(map[K]V, s)
+	}
 
 	for range s {
-		switch p := kUnmarshaler.(type) {
-		case func(n int, b []byte) (int, K, error):
-			n, k, err = p(n, b)
-			if err != nil {
-				return 0, nil, err
-			}
-		case func(n int, b []byte, k *K) (int, error):
-			n, err = p(n, b, &k)
-			if err != nil {
-				return 0, nil, err
-			}
-		default:
-			panic("benc: invalid `kUnmarshaler` provided in `UnmarshalMap`")
+		n, k, err = kUnmarshaler(n, b)
+		if err != nil {
+			return 0, nil, err
 		}
-
-		switch p := vUnmarshaler.(type) {
-		case func(n int, b []byte) (int, V, error):
-			n, v, err = p(n, b)
-			if err != nil {
-				return 0, nil, err
-			}
-		case func(n int, b []byte, v *V) (int, error):
-			n, err = p(n, b, &v)
-			if err != nil {
-				return 0, nil, err
-			}
-		default:
-			panic("benc: invalid `kUnmarshaler` provided in `UnmarshalMap`")
+		n, v, err = vUnmarshaler(n, b)
+		if err != nil {
+			return 0, nil, err
 		}
-
 		ts[k] = v
 	}
 
-	return n + 4, ts, nil
+	if legacy {
+		return skipLegacyTerminator(n, b, ts)
+	}
+	return n, ts, nil
 }
 
 // Returns the new offset 'n' after skipping the marshalled byte.
@@ -362,7 +701,7 @@ func UnmarshalMap[K comparable, V any](n int, b []byte, kUnmarshaler interface{}
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipByte(n int, b []byte) (int, error) {
 	if len(b)-n < 1 {
-		return n, benc.ErrBufTooSmall
+		return n, benc.NewDecodeError(n, "byte", benc.ErrBufTooSmall)
 	}
 	return n + 1, nil
 }
@@ -388,7 +727,7 @@ func MarshalByte(n int, b []byte, byt byte) int {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalByte(n int, b []byte) (int, byte, error) {
 	if len(b)-n < 1 {
-		return n, 0, benc.ErrBufTooSmall
+		return n, 0, benc.NewDecodeError(n, "byte", benc.ErrBufTooSmall)
 	}
 	return n + 1, b[n], nil
 }
@@ -406,8 +745,8 @@ func SkipBytes(n int, b []byte) (int, error) {
 		return 0, err
 	}
 	s := int(us)
-	if len(b)-n < s {
-		return n, benc.ErrBufTooSmall
+	if us > uint(len(b)-n) {
+		return n, benc.NewDecodeError(n, "[]byte", benc.ErrBufTooSmall)
 	}
 	return n + s, nil
 }
@@ -439,8 +778,8 @@ func UnmarshalBytesCopied(n int, b []byte) (int, []byte, error) {
 		return 0, nil, err
 	}
 	s := int(us)
-	if len(b)-n < s {
-		return 0, nil, benc.ErrBufTooSmall
+	if us > uint(len(b)-n) {
+		return 0, nil, benc.NewDecodeError(n, "[]byte", benc.ErrBufTooSmall)
 	}
 	cb := make([]byte, s)
 	copy(cb, b[n:n+s])
@@ -460,141 +799,83 @@ func UnmarshalBytesCropped(n int, b []byte) (int, []byte, error) {
 		return 0, nil, err
 	}
 	s := int(us)
-	if len(b)-n < s {
-		return 0, nil, benc.ErrBufTooSmall
+	if us > uint(len(b)-n) {
+		return 0, nil, benc.NewDecodeError(n, "[]byte", benc.ErrBufTooSmall)
 	}
 	return n + s, b[n : n+s], nil
 }
 
-var maxVarintLenMap = map[int]int{
-	64: binary.MaxVarintLen64,
-	32: binary.MaxVarintLen32,
-}
-
-var maxVarintLen = maxVarintLenMap[strconv.IntSize]
-
-// Returns the new offset 'n' after skipping the marshalled varint.
+// Returns the new offset 'n' after skipping the marshalled varint, see SkipVarint64.
 //
 // Possible errors returned:
-//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrOverflow          - varint overflowed a 64-bit unsigned integer.
 //   - benc.ErrBufTooSmall       - 'buf' was too small to skip the marshalled varint.
 //
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipVarint(n int, buf []byte) (int, error) {
-	for i, b := range buf[n:] {
-		if i == maxVarintLen {
-			return 0, benc.ErrOverflow
-		}
-		if b < 0x80 {
-			if i == maxVarintLen-1 && b > 1 {
-				return 0, benc.ErrOverflow
-			}
-			return n + i + 1, nil
-		}
-	}
-	return 0, benc.ErrBufTooSmall
+	return SkipVarint64(n, buf)
 }
 
 // Returns the bytes needed to marshal a integer.
 func SizeInt(sv int) int {
-	v := uint(encodeZigZag(sv))
-	i := 0
-	for v >= 0x80 {
-		v >>= 7
-		i++
-	}
-	return i + 1
+	return SizeVarint64(int64(sv))
 }
 
 // Returns the new offset 'n' after marshalling the integer.
+// It's marshalled as a 64-bit varint, see MarshalVarint64, so the bytes don't depend on the architecture.
 //
 // !- Panics, if 'b' is too small.
 func MarshalInt(n int, b []byte, sv int) int {
-	v := uint(encodeZigZag(sv))
-	i := n
-	for v >= 0x80 {
-		b[i] = byte(v) | 0x80
-		v >>= 7
-		i++
-	}
-	b[i] = byte(v)
-	return i + 1
+	return MarshalVarint64(n, b, int64(sv))
 }
 
 // Returns the new offset 'n', as well as the integer, that got unmarshalled.
 //
 // Possible errors returned:
-//   - benc.ErrOverflow          - varint overflowed a N-bit integer.
+//   - benc.ErrOverflow          - varint overflowed a 64-bit integer, or the integer of the architecture.
 //   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the integer.
 //
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalInt(n int, buf []byte) (int, int, error) {
-	var x uint
-	var s uint
-	for i, b := range buf[n:] {
-		if i == maxVarintLen {
-			return 0, 0, benc.ErrOverflow
-		}
-		if b < 0x80 {
-			if i == maxVarintLen-1 && b > 1 {
-				return 0, 0, benc.ErrOverflow
-			}
-			return n + i + 1, int(decodeZigZag(x | uint(b)<<s)), nil
-		}
-		x |= uint(b&0x7f) << s
-		s += 7
+	end, v, err := UnmarshalVarint64(n, buf)
+	if err != nil {
+		return 0, 0, err
+	}
+	if int64(int(v)) != v {
+		return 0, 0, benc.NewDecodeError(n, "int", benc.ErrOverflow)
 	}
-	return 0, 0, benc.ErrBufTooSmall
+	return end, int(v), nil
 }
 
 // Returns the bytes needed to marshal a unsigned integer.
 func SizeUint(v uint) int {
-	i := 0
-	for v >= 0x80 {
-		v >>= 7
-		i++
-	}
-	return i + 1
+	return SizeUvarint64(uint64(v))
 }
 
 // Returns the new offset 'n' after marshalling the unsigned integer.
+// It's marshalled as a 64-bit varint, see MarshalUvarint64, so the bytes don't depend on the architecture.
 //
 // !- Panics, if 'b' is too small.
 func MarshalUint(n int, b []byte, v uint) int {
-	i := n
-	for v >= 0x80 {
-		b[i] = byte(v) | 0x80
-		v >>= 7
-		i++
-	}
-	b[i] = byte(v)
-	return i + 1
+	return MarshalUvarint64(n, b, uint64(v))
 }
 
 // Returns the new offset 'n', as well as the unsigned integer, that got unmarshalled.
 //
 // Possible errors returned:
-//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer
-//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the unsigned integer//
+//   - benc.ErrOverflow          - varint overflowed a 64-bit unsigned integer, or the unsigned integer of the architecture.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the unsigned integer.
 //
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalUint(n int, buf []byte) (int, uint, error) {
-	var x uint
-	var s uint
-	for i, b := range buf[n:] {
-		if i == maxVarintLen {
-			return 0, 0, benc.ErrOverflow
-		}
-		if b < 0x80 {
-			if i == maxVarintLen-1 && b > 1 {
-				return 0, 0, benc.ErrOverflow
-			}
-			return n + i + 1, x | uint(b)<<s, nil
-		}
-		x |= uint(b&0x7f) << s
-		s += 7
+	end, v, err := UnmarshalUvarint64(n, buf)
+	if err != nil {
+		return 0, 0, err
+	}
+	if uint64(uint(v)) != v {
+		return 0, 0, benc.NewDecodeError(n, "uint", benc.ErrOverflow)
 	}
-	return 0, 0, benc.ErrBufTooSmall
+	return end, uint(v), nil
 }
 
 // Returns the new offset 'n' after skipping the marshalled 64-bit unsigned integer.
@@ -605,7 +886,7 @@ func UnmarshalUint(n int, buf []byte) (int, uint, error) {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipUint64(n int, b []byte) (int, error) {
 	if len(b)-n < 8 {
-		return n, benc.ErrBufTooSmall
+		return n, benc.NewDecodeError(n, "uint64", benc.ErrBufTooSmall)
 	}
 	return n + 8, nil
 }
@@ -638,7 +919,7 @@ func MarshalUint64(n int, b []byte, v uint64) int {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalUint64(n int, b []byte) (int, uint64, error) {
 	if len(b)-n < 8 {
-		return n, 0, benc.ErrBufTooSmall
+		return n, 0, benc.NewDecodeError(n, "uint64", benc.ErrBufTooSmall)
 	}
 	u := b[n : n+8]
 	_ = u[7]
@@ -655,7 +936,7 @@ func UnmarshalUint64(n int, b []byte) (int, uint64, error) {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipUint32(n int, b []byte) (int, error) {
 	if len(b)-n < 4 {
-		return n, benc.ErrBufTooSmall
+		return n, benc.NewDecodeError(n, "uint32", benc.ErrBufTooSmall)
 	}
 	return n + 4, nil
 }
@@ -684,7 +965,7 @@ func MarshalUint32(n int, b []byte, v uint32) int {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalUint32(n int, b []byte) (int, uint32, error) {
 	if len(b)-n < 4 {
-		return n, 0, benc.ErrBufTooSmall
+		return n, 0, benc.NewDecodeError(n, "uint32", benc.ErrBufTooSmall)
 	}
 	u := b[n : n+4]
 	_ = u[3]
@@ -700,7 +981,7 @@ func UnmarshalUint32(n int, b []byte) (int, uint32, error) {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipUint16(n int, b []byte) (int, error) {
 	if len(b)-n < 2 {
-		return n, benc.ErrBufTooSmall
+		return n, benc.NewDecodeError(n, "uint16", benc.ErrBufTooSmall)
 	}
 	return n + 2, nil
 }
@@ -727,7 +1008,7 @@ func MarshalUint16(n int, b []byte, v uint16) int {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalUint16(n int, b []byte) (int, uint16, error) {
 	if len(b)-n < 2 {
-		return n, 0, benc.ErrBufTooSmall
+		return n, 0, benc.NewDecodeError(n, "uint16", benc.ErrBufTooSmall)
 	}
 	u := b[n : n+2]
 	_ = u[1]
@@ -743,7 +1024,7 @@ func UnmarshalUint16(n int, b []byte) (int, uint16, error) {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipInt64(n int, b []byte) (int, error) {
 	if len(b)-n < 8 {
-		return n, benc.ErrBufTooSmall
+		return n, benc.NewDecodeError(n, "int64", benc.ErrBufTooSmall)
 	}
 	return n + 8, nil
 }
@@ -776,7 +1057,7 @@ func MarshalInt64(n int, b []byte, v int64) int {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalInt64(n int, b []byte) (int, int64, error) {
 	if len(b)-n < 8 {
-		return n, 0, benc.ErrBufTooSmall
+		return n, 0, benc.NewDecodeError(n, "int64", benc.ErrBufTooSmall)
 	}
 	u := b[n : n+8]
 	_ = u[7]
@@ -793,7 +1074,7 @@ func UnmarshalInt64(n int, b []byte) (int, int64, error) {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipInt32(n int, b []byte) (int, error) {
 	if len(b)-n < 4 {
-		return n, benc.ErrBufTooSmall
+		return n, benc.NewDecodeError(n, "int32", benc.ErrBufTooSmall)
 	}
 	return n + 4, nil
 }
@@ -822,7 +1103,7 @@ func MarshalInt32(n int, b []byte, v int32) int {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalInt32(n int, b []byte) (int, int32, error) {
 	if len(b)-n < 4 {
-		return n, 0, benc.ErrBufTooSmall
+		return n, 0, benc.NewDecodeError(n, "int32", benc.ErrBufTooSmall)
 	}
 	u := b[n : n+4]
 	_ = u[3]
@@ -838,7 +1119,7 @@ func UnmarshalInt32(n int, b []byte) (int, int32, error) {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipInt16(n int, b []byte) (int, error) {
 	if len(b)-n < 2 {
-		return n, benc.ErrBufTooSmall
+		return n, benc.NewDecodeError(n, "int16", benc.ErrBufTooSmall)
 	}
 	return n + 2, nil
 }
@@ -865,7 +1146,7 @@ func MarshalInt16(n int, b []byte, v int16) int {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalInt16(n int, b []byte) (int, int16, error) {
 	if len(b)-n < 2 {
-		return n, 0, benc.ErrBufTooSmall
+		return n, 0, benc.NewDecodeError(n, "int16", benc.ErrBufTooSmall)
 	}
 	u := b[n : n+2]
 	_ = u[1]
@@ -883,7 +1164,7 @@ func UnmarshalInt16(n int, b []byte) (int, int16, error) {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipFloat64(n int, b []byte) (int, error) {
 	if len(b)-n < 8 {
-		return n, benc.ErrBufTooSmall
+		return n, benc.NewDecodeError(n, "float64", benc.ErrBufTooSmall)
 	}
 	return n + 8, nil
 }
@@ -917,7 +1198,7 @@ func MarshalFloat64(n int, b []byte, v float64) int {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalFloat64(n int, b []byte) (int, float64, error) {
 	if len(b)-n < 8 {
-		return n, 0, benc.ErrBufTooSmall
+		return n, 0, benc.NewDecodeError(n, "float64", benc.ErrBufTooSmall)
 	}
 	u := b[n : n+8]
 	_ = u[7]
@@ -934,7 +1215,7 @@ func UnmarshalFloat64(n int, b []byte) (int, float64, error) {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipFloat32(n int, b []byte) (int, error) {
 	if len(b)-n < 4 {
-		return n, benc.ErrBufTooSmall
+		return n, benc.NewDecodeError(n, "float32", benc.ErrBufTooSmall)
 	}
 	return n + 4, nil
 }
@@ -964,7 +1245,7 @@ func MarshalFloat32(n int, b []byte, v float32) int {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalFloat32(n int, b []byte) (int, float32, error) {
 	if len(b)-n < 4 {
-		return n, 0, benc.ErrBufTooSmall
+		return n, 0, benc.NewDecodeError(n, "float32", benc.ErrBufTooSmall)
 	}
 	u := b[n : n+4]
 	_ = u[3]
@@ -980,7 +1261,7 @@ func UnmarshalFloat32(n int, b []byte) (int, float32, error) {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func SkipBool(n int, b []byte) (int, error) {
 	if len(b)-n < 1 {
-		return 0, benc.ErrBufTooSmall
+		return 0, benc.NewDecodeError(n, "bool", benc.ErrBufTooSmall)
 	}
 	return n + 1, nil
 }
@@ -1008,7 +1289,7 @@ func MarshalBool(n int, b []byte, v bool) int {
 // If a error is returned, n (the int returned) equals zero ( 0 ).
 func UnmarshalBool(n int, b []byte) (int, bool, error) {
 	if len(b)-n < 1 {
-		return 0, false, benc.ErrBufTooSmall
+		return 0, false, benc.NewDecodeError(n, "bool", benc.ErrBufTooSmall)
 	}
 	return n + 1, uint8(b[n]) == 1, nil
 }
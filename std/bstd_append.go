@@ -0,0 +1,163 @@
+package bstd
+
+import (
+	"slices"
+	"time"
+)
+
+type AppendFunc[T any] func(b []byte, t T) []byte
+
+// Grows 'b' by 's' bytes and marshals 't' into the grown bytes.
+func appendWith[T any](b []byte, s int, marshal MarshalFunc[T], t T) []byte {
+	n := len(b)
+	b = slices.Grow(b, s)[:n+s]
+	marshal(n, b, t)
+	return b
+}
+
+// Appends 's' bytes, marshalled by 'f', to 'b' and returns the extended buffer.
+// 's' is retrieved by using the benc `Size...` methods.
+func AppendWith(b []byte, s int, f func(n int, b []byte) int) []byte {
+	n := len(b)
+	b = slices.Grow(b, s)[:n+s]
+	f(n, b)
+	return b
+}
+
+// Appends the marshalled string to 'b' and returns the extended buffer.
+func AppendString(b []byte, str string) []byte {
+	b = AppendUint(b, uint(len(str)))
+	return append(b, str...)
+}
+
+// Appends the marshalled string to 'b' and returns the extended buffer.
+// Uses unsafe operations to convert the string to bytes.
+func AppendUnsafeString(b []byte, str string) []byte {
+	b = AppendUint(b, uint(len(str)))
+	return append(b, s2b(str)...)
+}
+
+// Appends the marshalled slice to 'b' and returns the extended buffer.
+func AppendSlice[T any](b []byte, slice []T, appender AppendFunc[T]) []byte {
+	b = append(b, collectionMarker0, collectionMarker1, 0, 0, 0, 0)
+	start := len(b)
+
+	b = AppendUint(b, uint(len(slice)))
+	for _, t := range slice {
+		b = appender(b, t)
+	}
+
+	finishCollectionHeader(start, len(b), b)
+	return b
+}
+
+// Appends the marshalled map to 'b' and returns the extended buffer.
+func AppendMap[K comparable, V any](b []byte, m map[K]V, kAppender AppendFunc[K], vAppender AppendFunc[V]) []byte {
+	b = append(b, collectionMarker0, collectionMarker1, 0, 0, 0, 0)
+	start := len(b)
+
+	b = AppendUint(b, uint(len(m)))
+	for k, v := range m {
+		b = kAppender(b, k)
+		b = vAppender(b, v)
+	}
+
+	finishCollectionHeader(start, len(b), b)
+	return b
+}
+
+// Appends the marshalled byte to 'b' and returns the extended buffer.
+func AppendByte(b []byte, byt byte) []byte {
+	return append(b, byt)
+}
+
+// Appends the marshalled byte slice to 'b' and returns the extended buffer.
+func AppendBytes(b []byte, bs []byte) []byte {
+	b = AppendUint(b, uint(len(bs)))
+	return append(b, bs...)
+}
+
+// Appends the marshalled integer to 'b' and returns the extended buffer.
+func AppendInt(b []byte, sv int) []byte {
+	return AppendVarint64(b, int64(sv))
+}
+
+// Appends the marshalled unsigned integer to 'b' and returns the extended buffer.
+func AppendUint(b []byte, v uint) []byte {
+	return AppendUvarint64(b, uint64(v))
+}
+
+// Appends the marshalled 64-bit varint to 'b' and returns the extended buffer.
+func AppendVarint64(b []byte, sv int64) []byte {
+	return appendUvarint(b, uint64(encodeZigZag(sv)))
+}
+
+// Appends the marshalled 64-bit unsigned varint to 'b' and returns the extended buffer.
+func AppendUvarint64(b []byte, v uint64) []byte {
+	return appendUvarint(b, v)
+}
+
+// Appends the marshalled 32-bit varint to 'b' and returns the extended buffer.
+func AppendVarint32(b []byte, sv int32) []byte {
+	return appendUvarint(b, uint32(encodeZigZag(sv)))
+}
+
+// Appends the marshalled 32-bit unsigned varint to 'b' and returns the extended buffer.
+func AppendUvarint32(b []byte, v uint32) []byte {
+	return appendUvarint(b, v)
+}
+
+// Appends the marshalled 64-bit unsigned integer to 'b' and returns the extended buffer.
+func AppendUint64(b []byte, v uint64) []byte {
+	return appendWith(b, 8, MarshalUint64, v)
+}
+
+// Appends the marshalled 32-bit unsigned integer to 'b' and returns the extended buffer.
+func AppendUint32(b []byte, v uint32) []byte {
+	return appendWith(b, 4, MarshalUint32, v)
+}
+
+// Appends the marshalled 16-bit unsigned integer to 'b' and returns the extended buffer.
+func AppendUint16(b []byte, v uint16) []byte {
+	return appendWith(b, 2, MarshalUint16, v)
+}
+
+// Appends the marshalled 64-bit integer to 'b' and returns the extended buffer.
+func AppendInt64(b []byte, v int64) []byte {
+	return appendWith(b, 8, MarshalInt64, v)
+}
+
+// Appends the marshalled 32-bit integer to 'b' and returns the extended buffer.
+func AppendInt32(b []byte, v int32) []byte {
+	return appendWith(b, 4, MarshalInt32, v)
+}
+
+// Appends the marshalled 16-bit integer to 'b' and returns the extended buffer.
+func AppendInt16(b []byte, v int16) []byte {
+	return appendWith(b, 2, MarshalInt16, v)
+}
+
+// Appends the marshalled 64-bit float to 'b' and returns the extended buffer.
+func AppendFloat64(b []byte, v float64) []byte {
+	return appendWith(b, 8, MarshalFloat64, v)
+}
+
+// Appends the marshalled 32-bit float to 'b' and returns the extended buffer.
+func AppendFloat32(b []byte, v float32) []byte {
+	return appendWith(b, 4, MarshalFloat32, v)
+}
+
+// Appends the marshalled bool to 'b' and returns the extended buffer.
+func AppendBool(b []byte, v bool) []byte {
+	return appendWith(b, 1, MarshalBool, v)
+}
+
+// Appends the marshalled time to 'b' and returns the extended buffer.
+func AppendTime(b []byte, v time.Time) []byte {
+	return appendWith(b, timeSize, MarshalTime, v)
+}
+
+// Appends the marshalled duration to 'b' and returns the extended buffer.
+func AppendDuration(b []byte, v time.Duration) []byte {
+	return appendWith(b, 8, MarshalDuration, v)
+}
@@ -0,0 +1,73 @@
+package bstd
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestAppend(t *testing.T) {
+	str := "Hello World!"
+	bs := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	slice := []string{"sliceelement1", "sliceelement2", "sliceelement3"}
+	nestedSlice := [][]uint64{{1, 2, 3}, {}, {4}}
+	m := map[string]int32{"mapkey1": 1}
+
+	s := SizeString(str) + SizeString(str) + SizeBytes(bs) + SizeByte() + SizeBool() + SizeInt(math.MinInt) + SizeInt16() + SizeInt32() + SizeInt64() +
+		SizeUint(math.MaxUint) + SizeUint16() + SizeUint32() + SizeUint64() + SizeFloat32() + SizeFloat64() +
+		SizeSlice(slice, SizeString) + SizeSlice(nestedSlice, func(s []uint64) int { return SizeFixedSlice(s, SizeUint64()) }) + SizeMap(m, SizeString, SizeInt32)
+	expected := make([]byte, s)
+	n := MarshalString(0, expected, str)
+	n = MarshalUnsafeString(n, expected, str)
+	n = MarshalBytes(n, expected, bs)
+	n = MarshalByte(n, expected, 128)
+	n = MarshalBool(n, expected, true)
+	n = MarshalInt(n, expected, math.MinInt)
+	n = MarshalInt16(n, expected, -16)
+	n = MarshalInt32(n, expected, -32)
+	n = MarshalInt64(n, expected, -64)
+	n = MarshalUint(n, expected, math.MaxUint)
+	n = MarshalUint16(n, expected, 16)
+	n = MarshalUint32(n, expected, 32)
+	n = MarshalUint64(n, expected, 64)
+	n = MarshalFloat32(n, expected, 32.5)
+	n = MarshalFloat64(n, expected, 64.5)
+	n = MarshalSlice(n, expected, slice, MarshalString)
+	n = MarshalSlice(n, expected, nestedSlice, func(n int, b []byte, s []uint64) int { return MarshalSlice(n, b, s, MarshalUint64) })
+	MarshalMap(n, expected, m, MarshalString, MarshalInt32)
+
+	prefix := []byte{0xFF, 0xFE}
+	b := append([]byte{}, prefix...)
+	b = AppendString(b, str)
+	b = AppendUnsafeString(b, str)
+	b = AppendBytes(b, bs)
+	b = AppendByte(b, 128)
+	b = AppendBool(b, true)
+	b = AppendInt(b, math.MinInt)
+	b = AppendInt16(b, -16)
+	b = AppendInt32(b, -32)
+	b = AppendInt64(b, -64)
+	b = AppendUint(b, math.MaxUint)
+	b = AppendUint16(b, 16)
+	b = AppendUint32(b, 32)
+	b = AppendUint64(b, 64)
+	b = AppendFloat32(b, 32.5)
+	b = AppendFloat64(b, 64.5)
+	b = AppendSlice(b, slice, AppendString)
+	b = AppendSlice(b, nestedSlice, func(b []byte, s []uint64) []byte { return AppendSlice(b, s, AppendUint64) })
+	b = AppendMap(b, m, AppendString, AppendInt32)
+
+	if !bytes.Equal(b[:len(prefix)], prefix) {
+		t.Fatalf("prefix got overwritten: %v", b[:len(prefix)])
+	}
+	if !bytes.Equal(b[len(prefix):], expected) {
+		t.Fatalf("no match\nappend: %v\noffset: %v", b[len(prefix):], expected)
+	}
+
+	b = AppendWith(nil, SizeString(str), func(n int, b []byte) int {
+		return MarshalString(n, b, str)
+	})
+	if !bytes.Equal(b, AppendString(nil, str)) {
+		t.Fatalf("AppendWith: no match: %v", b)
+	}
+}
@@ -0,0 +1,138 @@
+package bstd
+
+import (
+	"github.com/deneonet/benc"
+)
+
+// A bool slice is packed, 8 bools per byte: the element count (varint), followed by the bits,
+// the first bool is the lowest bit of the first byte. Unused bits of the last byte are zero.
+//
+// A packed bool slice never starts with the collection header, so bool slices, marshalled by MarshalSlice,
+// are still unmarshalled by UnmarshalBoolSlice. Legacy (v1) bool slices have no collection header,
+// they can't be told apart from packed ones, unmarshal them with UnmarshalSlice instead.
+
+// Returns the bytes needed to hold 's' packed bools.
+func packedBoolsLen(s uint) uint {
+	return s/8 + min(s%8, 1)
+}
+
+// Returns the new offset 'n' after skipping the marshalled bool slice, see MarshalBoolSlice.
+// Bool slices marshalled by MarshalSlice are skipped as well.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to skip the marshalled bool slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func SkipBoolSlice(n int, b []byte) (int, error) {
+	if hasCollectionHeader(n, b) {
+		return skipCollection(n, b)
+	}
+
+	n, us, err := UnmarshalUint(n, b)
+	if err != nil {
+		return 0, err
+	}
+
+	if packedBoolsLen(us) > uint(len(b)-n) {
+		return 0, benc.NewDecodeError(n, "bool slice", benc.ErrBufTooSmall)
+	}
+	return n + int(packedBoolsLen(us)), nil
+}
+
+// Returns the bytes needed to marshal the bool slice.
+func SizeBoolSlice(slice []bool) int {
+	s := uint(len(slice))
+	return SizeUint(s) + int(packedBoolsLen(s))
+}
+
+// Returns the new offset 'n' after marshalling the bool slice, packed 8 bools per byte.
+//
+// !- Panics, if 'b' is too small.
+func MarshalBoolSlice(n int, b []byte, slice []bool) int {
+	n = MarshalUint(n, b, uint(len(slice)))
+
+	u := b[n : n+int(packedBoolsLen(uint(len(slice))))]
+	clear(u)
+	for i, v := range slice {
+		if v {
+			u[i/8] |= 1 << (i % 8)
+		}
+	}
+	return n + len(u)
+}
+
+// Appends the marshalled bool slice to 'b', packed 8 bools per byte, and returns the extended buffer.
+func AppendBoolSlice(b []byte, slice []bool) []byte {
+	b = AppendUint(b, uint(len(slice)))
+
+	var c byte
+	for i, v := range slice {
+		if v {
+			c |= 1 << (i % 8)
+		}
+		if i%8 == 7 {
+			b = append(b, c)
+			c = 0
+		}
+	}
+	if len(slice)%8 != 0 {
+		b = append(b, c)
+	}
+	return b
+}
+
+// Returns the new offset 'n', as well as the bool slice, that got unmarshalled, see MarshalBoolSlice.
+// Bool slices marshalled by MarshalSlice are unmarshalled as well.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the bool slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalBoolSlice(n int, b []byte) (int, []bool, error) {
+	return UnmarshalBoolSliceIntoLimited(nil, n, b, nil)
+}
+
+// Returns the new offset 'n', as well as the bool slice, that got unmarshalled, see UnmarshalBoolSlice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the bool slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalBoolSliceLimited(l *DecodeLimits, n int, b []byte) (int, []bool, error) {
+	return UnmarshalBoolSliceIntoLimited(l, n, b, nil)
+}
+
+// Returns the new offset 'n', as well as the bool slice, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the bool slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalBoolSliceIntoLimited(l *DecodeLimits, n int, b []byte, dst []bool) (int, []bool, error) {
+	if hasCollectionHeader(n, b) {
+		return unmarshalSliceInto(l, n, b, dst, ToUnmarshalIntoFunc(UnmarshalBool))
+	}
+
+	n, us, err := UnmarshalUint(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Every byte packs eight bools
+	ts, err := sliceInto(l, n, us, bitsIn(len(b)-n), dst)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for i := range ts {
+		ts[i] = b[n+i/8]&(1<<(i%8)) != 0
+	}
+	return n + int(packedBoolsLen(us)), ts, nil
+}
@@ -0,0 +1,95 @@
+package bstd
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/deneonet/benc"
+)
+
+func TestBoolSlice(t *testing.T) {
+	for _, slice := range [][]bool{
+		{},
+		{true},
+		{true, false, true, true, false, false, false, true},
+		{false, false, false, false, false, false, false, false, true},
+		{true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false},
+	} {
+		s := SizeBoolSlice(slice)
+		if want := 1 + (len(slice)+7)/8; s != want {
+			t.Fatalf("%v: size %d, want %d", slice, s, want)
+		}
+
+		buf := make([]byte, s)
+		for i := range buf {
+			buf[i] = 0xff
+		}
+		if n := MarshalBoolSlice(0, buf, slice); n != s {
+			t.Fatalf("%v: n %d, want %d", slice, n, s)
+		}
+		if b := AppendBoolSlice(nil, slice); !bytes.Equal(b, buf) {
+			t.Fatalf("%v: append: no match\norg %v\ndec %v", slice, buf, b)
+		}
+
+		if err := SkipOnce_Verify(buf, SkipBoolSlice); err != nil {
+			t.Fatal(err.Error())
+		}
+
+		n, retSlice, err := UnmarshalBoolSlice(0, buf)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if n != s || !reflect.DeepEqual(retSlice, slice) {
+			t.Fatalf("no match: n %d\norg %v\ndec %v", n, slice, retSlice)
+		}
+	}
+}
+
+func TestBoolSliceLayout(t *testing.T) {
+	buf := AppendBoolSlice(nil, []bool{true, false, false, false, false, false, false, false, false, true})
+	if !bytes.Equal(buf, []byte{10, 0x01, 0x02}) {
+		t.Fatalf("unexpected layout %v", buf)
+	}
+}
+
+func TestBoolSliceFromMarshalSlice(t *testing.T) {
+	slice := []bool{true, false, true}
+	buf := make([]byte, SizeFixedSlice(slice, SizeBool()))
+	MarshalSlice(0, buf, slice, MarshalBool)
+
+	if err := SkipOnce_Verify(buf, SkipBoolSlice); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, retSlice, err := UnmarshalBoolSlice(0, buf)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retSlice, slice) {
+		t.Fatalf("no match\norg %v\ndec %v", slice, retSlice)
+	}
+}
+
+func TestBoolSliceErrors(t *testing.T) {
+	slice := make([]bool, 20)
+	buf := make([]byte, SizeBoolSlice(slice))
+	MarshalBoolSlice(0, buf, slice)
+
+	if _, _, err := UnmarshalBoolSlice(0, buf[:len(buf)-1]); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+	if _, err := SkipBoolSlice(0, buf[:len(buf)-1]); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+	if _, _, err := UnmarshalBoolSliceLimited(&DecodeLimits{MaxSliceLen: 19}, 0, buf); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatalf("expected benc.ErrLimitExceeded, got %v", err)
+	}
+
+	// A huge count doesn't allocate
+	huge := AppendUint(nil, 1<<30)
+	if _, _, err := UnmarshalBoolSlice(0, huge); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package bstd
+
+import (
+	"bytes"
+	"cmp"
+	"slices"
+)
+
+// The offsets of a marshalled map entry.
+type mapEntry struct {
+	start  int
+	keyEnd int
+	end    int
+}
+
+// The offsets of a marshalled map entry and its key.
+type sortedEntry[K cmp.Ordered] struct {
+	k K
+	mapEntry
+}
+
+// Returns the bytes of the marshalled entry 'e', in 'body', which starts at 'start'.
+func (e mapEntry) bytes(body []byte, start int) []byte {
+	return body[e.start-start : e.end-start]
+}
+
+// Sorts the marshalled entries, that are between 'start' and 'end' in 'b', by their marshalled keys.
+// Equal keys, like NaNs with the same bits, are sorted by the bytes of the whole entry, so the order never depends on the map order.
+func sortMapEntries(b []byte, start int, end int, entries []mapEntry) {
+	body := slices.Clone(b[start:end])
+	slices.SortFunc(entries, func(x, y mapEntry) int {
+		if c := bytes.Compare(body[x.start-start:x.keyEnd-start], body[y.start-start:y.keyEnd-start]); c != 0 {
+			return c
+		}
+		return bytes.Compare(x.bytes(body, start), y.bytes(body, start))
+	})
+
+	n := start
+	for _, e := range entries {
+		n += copy(b[n:], e.bytes(body, start))
+	}
+}
+
+// Sorts the marshalled entries, that are between 'start' and 'end' in 'b', in ascending key order.
+// NaN keys equal each other, so they are sorted by the bytes of the whole entry, the order never depends on the map order.
+func sortMapEntriesByKey[K cmp.Ordered](b []byte, start int, end int, entries []sortedEntry[K]) {
+	body := slices.Clone(b[start:end])
+	slices.SortFunc(entries, func(x, y sortedEntry[K]) int {
+		if c := cmp.Compare(x.k, y.k); c != 0 {
+			return c
+		}
+		return bytes.Compare(x.bytes(body, start), y.bytes(body, start))
+	})
+
+	n := start
+	for _, e := range entries {
+		n += copy(b[n:], e.bytes(body, start))
+	}
+}
+
+// Returns the new offset 'n' after marshalling the map, with its entries in ascending key order.
+// The same map always results in the same bytes, see MarshalCanonicalMap for keys, that are not ordered.
+//
+// !- Panics, if 'b' is too small.
+func MarshalSortedMap[K cmp.Ordered, V any](n int, b []byte, m map[K]V, kMarshaler MarshalFunc[K], vMarshaler MarshalFunc[V]) int {
+	start := reserveCollectionHeader(n, b)
+	n = MarshalUint(start, b, uint(len(m)))
+
+	bodyStart := n
+	entries := make([]sortedEntry[K], 0, len(m))
+	for k, v := range m {
+		e := sortedEntry[K]{k: k, mapEntry: mapEntry{start: n}}
+		n = kMarshaler(n, b, k)
+		e.keyEnd = n
+		n = vMarshaler(n, b, v)
+		e.end = n
+		entries = append(entries, e)
+	}
+
+	sortMapEntriesByKey(b, bodyStart, n, entries)
+	return finishCollectionHeader(start, n, b)
+}
+
+// Returns the new offset 'n' after marshalling the map, with its entries sorted by their marshalled keys.
+// The same map always results in the same bytes, for every key type, for example containers.
+//
+// !- Panics, if 'b' is too small.
+func MarshalCanonicalMap[K comparable, V any](n int, b []byte, m map[K]V, kMarshaler MarshalFunc[K], vMarshaler MarshalFunc[V]) int {
+	start := reserveCollectionHeader(n, b)
+	n = MarshalUint(start, b, uint(len(m)))
+
+	bodyStart := n
+	entries := make([]mapEntry, 0, len(m))
+	for k, v := range m {
+		e := mapEntry{start: n}
+		n = kMarshaler(n, b, k)
+		e.keyEnd = n
+		n = vMarshaler(n, b, v)
+		e.end = n
+		entries = append(entries, e)
+	}
+
+	sortMapEntries(b, bodyStart, n, entries)
+	return finishCollectionHeader(start, n, b)
+}
+
+// Appends the marshalled map, with its entries in ascending key order, to 'b' and returns the extended buffer.
+func AppendSortedMap[K cmp.Ordered, V any](b []byte, m map[K]V, kAppender AppendFunc[K], vAppender AppendFunc[V]) []byte {
+	b = append(b, collectionMarker0, collectionMarker1, 0, 0, 0, 0)
+	start := len(b)
+
+	b = AppendUint(b, uint(len(m)))
+
+	bodyStart := len(b)
+	entries := make([]sortedEntry[K], 0, len(m))
+	for k, v := range m {
+		e := sortedEntry[K]{k: k, mapEntry: mapEntry{start: len(b)}}
+		b = kAppender(b, k)
+		e.keyEnd = len(b)
+		b = vAppender(b, v)
+		e.end = len(b)
+		entries = append(entries, e)
+	}
+
+	sortMapEntriesByKey(b, bodyStart, len(b), entries)
+	finishCollectionHeader(start, len(b), b)
+	return b
+}
+
+// Appends the marshalled map, with its entries sorted by their marshalled keys, to 'b' and returns the extended buffer.
+func AppendCanonicalMap[K comparable, V any](b []byte, m map[K]V, kAppender AppendFunc[K], vAppender AppendFunc[V]) []byte {
+	b = append(b, collectionMarker0, collectionMarker1, 0, 0, 0, 0)
+	start := len(b)
+
+	b = AppendUint(b, uint(len(m)))
+
+	bodyStart := len(b)
+	entries := make([]mapEntry, 0, len(m))
+	for k, v := range m {
+		e := mapEntry{start: len(b)}
+		b = kAppender(b, k)
+		e.keyEnd = len(b)
+		b = vAppender(b, v)
+		e.end = len(b)
+		entries = append(entries, e)
+	}
+
+	sortMapEntries(b, bodyStart, len(b), entries)
+	finishCollectionHeader(start, len(b), b)
+	return b
+}
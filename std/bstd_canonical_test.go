@@ -0,0 +1,151 @@
+package bstd
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type canonicalKey struct {
+	name string
+	id   int
+}
+
+func sizeCanonicalKey(k canonicalKey) int {
+	return SizeString(k.name) + SizeInt(k.id)
+}
+
+func marshalCanonicalKey(n int, b []byte, k canonicalKey) int {
+	n = MarshalString(n, b, k.name)
+	return MarshalInt(n, b, k.id)
+}
+
+func appendCanonicalKey(b []byte, k canonicalKey) []byte {
+	b = AppendString(b, k.name)
+	return AppendInt(b, k.id)
+}
+
+func unmarshalCanonicalKey(n int, b []byte, k *canonicalKey) (int, error) {
+	var err error
+	if n, k.name, err = UnmarshalString(n, b); err != nil {
+		return 0, err
+	}
+	n, k.id, err = UnmarshalInt(n, b)
+	return n, err
+}
+
+func TestSortedMap(t *testing.T) {
+	m := make(map[int32]string)
+	for i := int32(-50); i < 50; i++ {
+		m[i*7%101] = "value"
+	}
+
+	b := make([]byte, SizeMap(m, SizeInt32, SizeString))
+	if n := MarshalSortedMap(0, b, m, MarshalInt32, MarshalString); n != len(b) {
+		t.Fatalf("expected offset %d, got %d", len(b), n)
+	}
+
+	// Sorted entries are the same as a slice of the entries in ascending key order.
+	keys := slices.Sorted(maps.Keys(m))
+	expected := make([]byte, len(b))
+	MarshalSlice(0, expected, keys, func(n int, b []byte, k int32) int {
+		n = MarshalInt32(n, b, k)
+		return MarshalString(n, b, m[k])
+	})
+	if !bytes.Equal(b, expected) {
+		t.Fatal("entries are not in ascending key order")
+	}
+
+	for i := 0; i < 10; i++ {
+		if !bytes.Equal(AppendSortedMap(nil, m, AppendInt32, AppendString), b) {
+			t.Fatal("append: no match")
+		}
+	}
+
+	_, retMap, err := UnmarshalMap[int32, string](0, b, UnmarshalInt32, UnmarshalString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(retMap, m) {
+		t.Fatal("no match")
+	}
+}
+
+type floatEntry struct {
+	k float64
+	v string
+}
+
+func TestSortedMapNaNKeys(t *testing.T) {
+	m := map[float64]string{1.5: "one", -2: "two"}
+	for i := 5; i > 0; i-- {
+		m[math.NaN()] = fmt.Sprint("nan", i)
+	}
+
+	// NaNs are less than every other key, equal keys are sorted by their values
+	expectedEntries := []floatEntry{
+		{math.NaN(), "nan1"}, {math.NaN(), "nan2"}, {math.NaN(), "nan3"}, {math.NaN(), "nan4"}, {math.NaN(), "nan5"},
+		{-2, "two"}, {1.5, "one"},
+	}
+	expected := AppendSlice(nil, expectedEntries, func(b []byte, e floatEntry) []byte {
+		return AppendString(AppendFloat64(b, e.k), e.v)
+	})
+
+	// The map order is random, so it is marshalled several times
+	for i := 0; i < 50; i++ {
+		b := make([]byte, SizeMap(m, SizeFloat64, SizeString))
+		if n := MarshalSortedMap(0, b, m, MarshalFloat64, MarshalString); n != len(b) {
+			t.Fatalf("expected offset %d, got %d", len(b), n)
+		}
+		if !bytes.Equal(b, expected) {
+			t.Fatalf("no match\norg %v\ndec %v", expected, b)
+		}
+		if !bytes.Equal(AppendSortedMap(nil, m, AppendFloat64, AppendString), expected) {
+			t.Fatal("append: no match")
+		}
+	}
+
+	_, retMap, err := UnmarshalMap[float64, string](0, expected, UnmarshalFloat64, UnmarshalString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retMap) != len(m) {
+		t.Fatalf("expected %d entries, got %d", len(m), len(retMap))
+	}
+}
+
+func TestCanonicalMap(t *testing.T) {
+	m := make(map[canonicalKey]float64)
+	for i := 0; i < 100; i++ {
+		m[canonicalKey{name: string(rune('a' + i%26)), id: -i}] = float64(i)
+	}
+
+	s := SizeMap(m, sizeCanonicalKey, SizeFloat64)
+	b := make([]byte, s)
+	if n := MarshalCanonicalMap(0, b, m, marshalCanonicalKey, MarshalFloat64); n != s {
+		t.Fatalf("expected offset %d, got %d", s, n)
+	}
+
+	for i := 0; i < 10; i++ {
+		retB := make([]byte, s)
+		MarshalCanonicalMap(0, retB, m, marshalCanonicalKey, MarshalFloat64)
+		if !bytes.Equal(retB, b) {
+			t.Fatal("marshal: not deterministic")
+		}
+		if !bytes.Equal(AppendCanonicalMap([]byte{}, m, appendCanonicalKey, AppendFloat64), b) {
+			t.Fatal("append: no match")
+		}
+	}
+
+	_, retMap, err := UnmarshalMap[canonicalKey, float64](0, b, unmarshalCanonicalKey, UnmarshalFloat64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(retMap, m) {
+		t.Fatal("no match")
+	}
+}
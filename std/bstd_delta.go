@@ -0,0 +1,134 @@
+package bstd
+
+import (
+	"github.com/deneonet/benc"
+	"golang.org/x/exp/constraints"
+)
+
+// A delta slice is marshalled like MarshalSlice does, but every element is marshalled as the difference
+// to the previous element (the first to zero), as a zigzag encoded 64-bit varint.
+// Sorted slices, like timestamps or ids, need only one or two bytes for most elements.
+//
+// It has the collection header, so SkipSlice skips it.
+
+// Returns the zigzag encoded difference of 'v' and 'prev', computed modulo 2^64.
+func encodeDelta[T constraints.Integer](v T, prev T) uint64 {
+	d := uint64(v) - uint64(prev)
+	return d<<1 ^ uint64(int64(d)>>63)
+}
+
+// Returns the value, that 'zz' is the zigzag encoded difference of to 'prev', see encodeDelta.
+func decodeDelta(zz uint64, prev uint64) uint64 {
+	return prev + (zz>>1 ^ -(zz & 1))
+}
+
+// Returns the bytes needed to marshal the delta slice.
+func SizeDeltaSlice[T constraints.Integer](slice []T) int {
+	s := collectionHeaderSize + SizeUint(uint(len(slice)))
+
+	var prev T
+	for _, v := range slice {
+		s += sizeUvarint(encodeDelta(v, prev))
+		prev = v
+	}
+	return s
+}
+
+// Returns the new offset 'n' after marshalling the delta slice.
+//
+// !- Panics, if 'b' is too small.
+func MarshalDeltaSlice[T constraints.Integer](n int, b []byte, slice []T) int {
+	start := reserveCollectionHeader(n, b)
+	n = MarshalUint(start, b, uint(len(slice)))
+
+	var prev T
+	for _, v := range slice {
+		n = marshalUvarint(n, b, encodeDelta(v, prev))
+		prev = v
+	}
+	return finishCollectionHeader(start, n, b)
+}
+
+// Appends the marshalled delta slice to 'b' and returns the extended buffer.
+func AppendDeltaSlice[T constraints.Integer](b []byte, slice []T) []byte {
+	b = append(b, collectionMarker0, collectionMarker1, 0, 0, 0, 0)
+	start := len(b)
+
+	b = AppendUint(b, uint(len(slice)))
+
+	var prev T
+	for _, v := range slice {
+		b = appendUvarint(b, encodeDelta(v, prev))
+		prev = v
+	}
+
+	finishCollectionHeader(start, len(b), b)
+	return b
+}
+
+// Returns the new offset 'n', as well as the delta slice, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a 64-bit integer or an element overflowed 'T'.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the delta slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalDeltaSlice[T constraints.Integer](n int, b []byte) (int, []T, error) {
+	return UnmarshalDeltaSliceIntoLimited[T](nil, n, b, nil)
+}
+
+// Returns the new offset 'n', as well as the delta slice, that got unmarshalled, see UnmarshalDeltaSlice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a 64-bit integer or an element overflowed 'T'.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the delta slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalDeltaSliceLimited[T constraints.Integer](l *DecodeLimits, n int, b []byte) (int, []T, error) {
+	return UnmarshalDeltaSliceIntoLimited[T](l, n, b, nil)
+}
+
+// Returns the new offset 'n', as well as the delta slice, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a 64-bit integer or an element overflowed 'T'.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the delta slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalDeltaSliceIntoLimited[T constraints.Integer](l *DecodeLimits, n int, b []byte, dst []T) (int, []T, error) {
+	n, end, err := unmarshalCollectionHeader(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+	b = b[:end]
+
+	n, us, err := UnmarshalUint(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Every element needs at least one byte
+	ts, err := sliceInto(l, n, us, uint(end-n), dst)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var prev uint64
+	for i := range ts {
+		tn := n
+		var zz uint64
+		if n, zz, err = UnmarshalUvarint64(n, b); err != nil {
+			return 0, nil, err
+		}
+
+		prev = decodeDelta(zz, prev)
+		ts[i] = T(prev)
+		if uint64(ts[i]) != prev {
+			return 0, nil, benc.NewDecodeError(tn, "delta slice", benc.ErrOverflow)
+		}
+	}
+	return end, ts, nil
+}
@@ -0,0 +1,88 @@
+package bstd
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/deneonet/benc"
+)
+
+func testDeltaSlice[T int | int16 | int32 | int64 | uint | uint16 | uint32 | uint64](t *testing.T, slice []T) {
+	t.Helper()
+
+	s := SizeDeltaSlice(slice)
+	buf := make([]byte, s)
+	if n := MarshalDeltaSlice(0, buf, slice); n != s {
+		t.Fatalf("%v: n %d, want %d", slice, n, s)
+	}
+	if b := AppendDeltaSlice([]byte{42}, slice); !bytes.Equal(b[1:], buf) {
+		t.Fatalf("%v: append: no match\norg %v\ndec %v", slice, buf, b[1:])
+	}
+
+	if err := SkipOnce_Verify(buf, SkipSlice); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	n, retSlice, err := UnmarshalDeltaSlice[T](0, buf)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if n != s || !reflect.DeepEqual(retSlice, slice) {
+		t.Fatalf("no match: n %d\norg %v\ndec %v", n, slice, retSlice)
+	}
+}
+
+func TestDeltaSlices(t *testing.T) {
+	testDeltaSlice(t, []int64{})
+	testDeltaSlice(t, []int64{1717171717000, 1717171717001, 1717171717500, 1717171717000, math.MinInt64, math.MaxInt64})
+	testDeltaSlice(t, []int{-1, 0, 1, math.MaxInt, math.MinInt})
+	testDeltaSlice(t, []int16{math.MaxInt16, math.MinInt16, 0})
+	testDeltaSlice(t, []int32{math.MinInt32, math.MaxInt32})
+	testDeltaSlice(t, []uint{0, math.MaxUint, 1})
+	testDeltaSlice(t, []uint16{math.MaxUint16, 0})
+	testDeltaSlice(t, []uint32{1, 2, 3, math.MaxUint32})
+	testDeltaSlice(t, []uint64{math.MaxUint64, 0, math.MaxUint64})
+}
+
+func TestDeltaSliceSize(t *testing.T) {
+	ts := make([]int64, 1000)
+	for i := range ts {
+		ts[i] = 1717171717000 + int64(i)*50
+	}
+
+	// Only the first element needs more than one byte
+	if s := SizeDeltaSlice(ts); s != collectionHeaderSize+SizeUint(1000)+SizeVarint64(ts[0])+999 {
+		t.Fatalf("unexpected size %d", s)
+	}
+}
+
+func TestDeltaSliceErrors(t *testing.T) {
+	slice := []int64{1, 300, 100000}
+	buf := make([]byte, SizeDeltaSlice(slice))
+	MarshalDeltaSlice(0, buf, slice)
+
+	if _, _, err := UnmarshalDeltaSlice[int64](0, buf[:len(buf)-1]); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+	if _, _, err := UnmarshalDeltaSliceLimited[int64](&DecodeLimits{MaxSliceLen: 2}, 0, buf); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatalf("expected benc.ErrLimitExceeded, got %v", err)
+	}
+
+	// 100000 doesn't fit into a 16-bit integer
+	if _, _, err := UnmarshalDeltaSlice[int16](0, buf); !errors.Is(err, benc.ErrOverflow) {
+		t.Fatalf("expected benc.ErrOverflow, got %v", err)
+	}
+
+	// Reuses 'dst'
+	dst := make([]int64, 0, 3)
+	_, retSlice, err := UnmarshalDeltaSliceIntoLimited(nil, 0, buf, dst)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retSlice, slice) || &retSlice[0] != &dst[:1][0] {
+		t.Fatal("into dst: no match!")
+	}
+}
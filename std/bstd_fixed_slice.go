@@ -0,0 +1,466 @@
+package bstd
+
+import (
+	"unsafe"
+)
+
+// The slices of fixed-width numbers below are marshalled the same way as MarshalSlice does,
+// but on little-endian hosts, the memory of a slice already equals its marshalled elements,
+// so they are copied at once, instead of marshalling every element on its own.
+// On big-endian hosts (or with the `purego` build tag), every element is marshalled on its own.
+
+// Numbers, that are marshalled little-endian with a fixed size.
+type fixedNumber interface {
+	~int16 | ~uint16 | ~int32 | ~uint32 | ~int64 | ~uint64 | ~float32 | ~float64
+}
+
+// Returns the memory of 'slice' as bytes.
+func fixedSliceBytes[T fixedNumber](slice []T) []byte {
+	if len(slice) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(slice))), len(slice)*int(unsafe.Sizeof(slice[0])))
+}
+
+// Returns the new offset 'n' after marshalling the slice, 'marshaler' is used on big-endian hosts.
+//
+// !- Panics, if 'b' is too small.
+func marshalFixedSlice[T fixedNumber](n int, b []byte, slice []T, marshaler MarshalFunc[T]) int {
+	if !nativeLittleEndian {
+		return MarshalSlice(n, b, slice, marshaler)
+	}
+
+	start := reserveCollectionHeader(n, b)
+	n = MarshalUint(start, b, uint(len(slice)))
+
+	p := fixedSliceBytes(slice)
+	n += copy(b[n:n+len(p)], p)
+	return finishCollectionHeader(start, n, b)
+}
+
+// Appends the marshalled slice to 'b' and returns the extended buffer, 'appender' is used on big-endian hosts.
+func appendFixedSlice[T fixedNumber](b []byte, slice []T, appender AppendFunc[T]) []byte {
+	if !nativeLittleEndian {
+		return AppendSlice(b, slice, appender)
+	}
+
+	b = append(b, collectionMarker0, collectionMarker1, 0, 0, 0, 0)
+	start := len(b)
+
+	b = AppendUint(b, uint(len(slice)))
+	b = append(b, fixedSliceBytes(slice)...)
+
+	finishCollectionHeader(start, len(b), b)
+	return b
+}
+
+// Returns the new offset 'n', as well as the slice, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+// 'unmarshaler' is used on big-endian hosts and for slices marshalled without the collection header (v1).
+func unmarshalFixedSlice[T fixedNumber](l *DecodeLimits, n int, b []byte, dst []T, unmarshaler UnmarshalFunc[T]) (int, []T, error) {
+	if !nativeLittleEndian || !hasCollectionHeader(n, b) {
+		return unmarshalSliceInto(l, n, b, dst, ToUnmarshalIntoFunc(unmarshaler))
+	}
+
+	n, end, err := unmarshalCollectionHeader(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	n, us, err := UnmarshalUint(n, b[:end])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var t T
+	ts, err := sliceInto(l, n, us, uint(end-n)/uint(unsafe.Sizeof(t)), dst)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	copy(fixedSliceBytes(ts), b[n:end])
+	return end, ts, nil
+}
+
+// Returns the new offset 'n' after marshalling the slice of 16-bit integers, see MarshalSlice.
+//
+// !- Panics, if 'b' is too small.
+func MarshalInt16Slice(n int, b []byte, slice []int16) int {
+	return marshalFixedSlice(n, b, slice, MarshalInt16)
+}
+
+// Appends the marshalled slice of 16-bit integers to 'b' and returns the extended buffer, see AppendSlice.
+func AppendInt16Slice(b []byte, slice []int16) []byte {
+	return appendFixedSlice(b, slice, AppendInt16)
+}
+
+// Returns the new offset 'n', as well as the slice of 16-bit integers, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalInt16Slice(n int, b []byte) (int, []int16, error) {
+	return unmarshalFixedSlice(nil, n, b, nil, UnmarshalInt16)
+}
+
+// Returns the new offset 'n', as well as the slice of 16-bit integers, that got unmarshalled, see UnmarshalInt16Slice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalInt16SliceLimited(l *DecodeLimits, n int, b []byte) (int, []int16, error) {
+	return unmarshalFixedSlice(l, n, b, nil, UnmarshalInt16)
+}
+
+// Returns the new offset 'n', as well as the slice of 16-bit integers, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalInt16SliceIntoLimited(l *DecodeLimits, n int, b []byte, dst []int16) (int, []int16, error) {
+	return unmarshalFixedSlice(l, n, b, dst, UnmarshalInt16)
+}
+
+// Returns the new offset 'n' after marshalling the slice of 16-bit unsigned integers, see MarshalSlice.
+//
+// !- Panics, if 'b' is too small.
+func MarshalUint16Slice(n int, b []byte, slice []uint16) int {
+	return marshalFixedSlice(n, b, slice, MarshalUint16)
+}
+
+// Appends the marshalled slice of 16-bit unsigned integers to 'b' and returns the extended buffer, see AppendSlice.
+func AppendUint16Slice(b []byte, slice []uint16) []byte {
+	return appendFixedSlice(b, slice, AppendUint16)
+}
+
+// Returns the new offset 'n', as well as the slice of 16-bit unsigned integers, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUint16Slice(n int, b []byte) (int, []uint16, error) {
+	return unmarshalFixedSlice(nil, n, b, nil, UnmarshalUint16)
+}
+
+// Returns the new offset 'n', as well as the slice of 16-bit unsigned integers, that got unmarshalled, see UnmarshalUint16Slice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUint16SliceLimited(l *DecodeLimits, n int, b []byte) (int, []uint16, error) {
+	return unmarshalFixedSlice(l, n, b, nil, UnmarshalUint16)
+}
+
+// Returns the new offset 'n', as well as the slice of 16-bit unsigned integers, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUint16SliceIntoLimited(l *DecodeLimits, n int, b []byte, dst []uint16) (int, []uint16, error) {
+	return unmarshalFixedSlice(l, n, b, dst, UnmarshalUint16)
+}
+
+// Returns the new offset 'n' after marshalling the slice of 32-bit integers, see MarshalSlice.
+//
+// !- Panics, if 'b' is too small.
+func MarshalInt32Slice(n int, b []byte, slice []int32) int {
+	return marshalFixedSlice(n, b, slice, MarshalInt32)
+}
+
+// Appends the marshalled slice of 32-bit integers to 'b' and returns the extended buffer, see AppendSlice.
+func AppendInt32Slice(b []byte, slice []int32) []byte {
+	return appendFixedSlice(b, slice, AppendInt32)
+}
+
+// Returns the new offset 'n', as well as the slice of 32-bit integers, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalInt32Slice(n int, b []byte) (int, []int32, error) {
+	return unmarshalFixedSlice(nil, n, b, nil, UnmarshalInt32)
+}
+
+// Returns the new offset 'n', as well as the slice of 32-bit integers, that got unmarshalled, see UnmarshalInt32Slice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalInt32SliceLimited(l *DecodeLimits, n int, b []byte) (int, []int32, error) {
+	return unmarshalFixedSlice(l, n, b, nil, UnmarshalInt32)
+}
+
+// Returns the new offset 'n', as well as the slice of 32-bit integers, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalInt32SliceIntoLimited(l *DecodeLimits, n int, b []byte, dst []int32) (int, []int32, error) {
+	return unmarshalFixedSlice(l, n, b, dst, UnmarshalInt32)
+}
+
+// Returns the new offset 'n' after marshalling the slice of 32-bit unsigned integers, see MarshalSlice.
+//
+// !- Panics, if 'b' is too small.
+func MarshalUint32Slice(n int, b []byte, slice []uint32) int {
+	return marshalFixedSlice(n, b, slice, MarshalUint32)
+}
+
+// Appends the marshalled slice of 32-bit unsigned integers to 'b' and returns the extended buffer, see AppendSlice.
+func AppendUint32Slice(b []byte, slice []uint32) []byte {
+	return appendFixedSlice(b, slice, AppendUint32)
+}
+
+// Returns the new offset 'n', as well as the slice of 32-bit unsigned integers, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUint32Slice(n int, b []byte) (int, []uint32, error) {
+	return unmarshalFixedSlice(nil, n, b, nil, UnmarshalUint32)
+}
+
+// Returns the new offset 'n', as well as the slice of 32-bit unsigned integers, that got unmarshalled, see UnmarshalUint32Slice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUint32SliceLimited(l *DecodeLimits, n int, b []byte) (int, []uint32, error) {
+	return unmarshalFixedSlice(l, n, b, nil, UnmarshalUint32)
+}
+
+// Returns the new offset 'n', as well as the slice of 32-bit unsigned integers, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUint32SliceIntoLimited(l *DecodeLimits, n int, b []byte, dst []uint32) (int, []uint32, error) {
+	return unmarshalFixedSlice(l, n, b, dst, UnmarshalUint32)
+}
+
+// Returns the new offset 'n' after marshalling the slice of 64-bit integers, see MarshalSlice.
+//
+// !- Panics, if 'b' is too small.
+func MarshalInt64Slice(n int, b []byte, slice []int64) int {
+	return marshalFixedSlice(n, b, slice, MarshalInt64)
+}
+
+// Appends the marshalled slice of 64-bit integers to 'b' and returns the extended buffer, see AppendSlice.
+func AppendInt64Slice(b []byte, slice []int64) []byte {
+	return appendFixedSlice(b, slice, AppendInt64)
+}
+
+// Returns the new offset 'n', as well as the slice of 64-bit integers, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalInt64Slice(n int, b []byte) (int, []int64, error) {
+	return unmarshalFixedSlice(nil, n, b, nil, UnmarshalInt64)
+}
+
+// Returns the new offset 'n', as well as the slice of 64-bit integers, that got unmarshalled, see UnmarshalInt64Slice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalInt64SliceLimited(l *DecodeLimits, n int, b []byte) (int, []int64, error) {
+	return unmarshalFixedSlice(l, n, b, nil, UnmarshalInt64)
+}
+
+// Returns the new offset 'n', as well as the slice of 64-bit integers, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalInt64SliceIntoLimited(l *DecodeLimits, n int, b []byte, dst []int64) (int, []int64, error) {
+	return unmarshalFixedSlice(l, n, b, dst, UnmarshalInt64)
+}
+
+// Returns the new offset 'n' after marshalling the slice of 64-bit unsigned integers, see MarshalSlice.
+//
+// !- Panics, if 'b' is too small.
+func MarshalUint64Slice(n int, b []byte, slice []uint64) int {
+	return marshalFixedSlice(n, b, slice, MarshalUint64)
+}
+
+// Appends the marshalled slice of 64-bit unsigned integers to 'b' and returns the extended buffer, see AppendSlice.
+func AppendUint64Slice(b []byte, slice []uint64) []byte {
+	return appendFixedSlice(b, slice, AppendUint64)
+}
+
+// Returns the new offset 'n', as well as the slice of 64-bit unsigned integers, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUint64Slice(n int, b []byte) (int, []uint64, error) {
+	return unmarshalFixedSlice(nil, n, b, nil, UnmarshalUint64)
+}
+
+// Returns the new offset 'n', as well as the slice of 64-bit unsigned integers, that got unmarshalled, see UnmarshalUint64Slice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUint64SliceLimited(l *DecodeLimits, n int, b []byte) (int, []uint64, error) {
+	return unmarshalFixedSlice(l, n, b, nil, UnmarshalUint64)
+}
+
+// Returns the new offset 'n', as well as the slice of 64-bit unsigned integers, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUint64SliceIntoLimited(l *DecodeLimits, n int, b []byte, dst []uint64) (int, []uint64, error) {
+	return unmarshalFixedSlice(l, n, b, dst, UnmarshalUint64)
+}
+
+// Returns the new offset 'n' after marshalling the slice of 32-bit floats, see MarshalSlice.
+//
+// !- Panics, if 'b' is too small.
+func MarshalFloat32Slice(n int, b []byte, slice []float32) int {
+	return marshalFixedSlice(n, b, slice, MarshalFloat32)
+}
+
+// Appends the marshalled slice of 32-bit floats to 'b' and returns the extended buffer, see AppendSlice.
+func AppendFloat32Slice(b []byte, slice []float32) []byte {
+	return appendFixedSlice(b, slice, AppendFloat32)
+}
+
+// Returns the new offset 'n', as well as the slice of 32-bit floats, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalFloat32Slice(n int, b []byte) (int, []float32, error) {
+	return unmarshalFixedSlice(nil, n, b, nil, UnmarshalFloat32)
+}
+
+// Returns the new offset 'n', as well as the slice of 32-bit floats, that got unmarshalled, see UnmarshalFloat32Slice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalFloat32SliceLimited(l *DecodeLimits, n int, b []byte) (int, []float32, error) {
+	return unmarshalFixedSlice(l, n, b, nil, UnmarshalFloat32)
+}
+
+// Returns the new offset 'n', as well as the slice of 32-bit floats, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalFloat32SliceIntoLimited(l *DecodeLimits, n int, b []byte, dst []float32) (int, []float32, error) {
+	return unmarshalFixedSlice(l, n, b, dst, UnmarshalFloat32)
+}
+
+// Returns the new offset 'n' after marshalling the slice of 64-bit floats, see MarshalSlice.
+//
+// !- Panics, if 'b' is too small.
+func MarshalFloat64Slice(n int, b []byte, slice []float64) int {
+	return marshalFixedSlice(n, b, slice, MarshalFloat64)
+}
+
+// Appends the marshalled slice of 64-bit floats to 'b' and returns the extended buffer, see AppendSlice.
+func AppendFloat64Slice(b []byte, slice []float64) []byte {
+	return appendFixedSlice(b, slice, AppendFloat64)
+}
+
+// Returns the new offset 'n', as well as the slice of 64-bit floats, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalFloat64Slice(n int, b []byte) (int, []float64, error) {
+	return unmarshalFixedSlice(nil, n, b, nil, UnmarshalFloat64)
+}
+
+// Returns the new offset 'n', as well as the slice of 64-bit floats, that got unmarshalled, see UnmarshalFloat64Slice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalFloat64SliceLimited(l *DecodeLimits, n int, b []byte) (int, []float64, error) {
+	return unmarshalFixedSlice(l, n, b, nil, UnmarshalFloat64)
+}
+
+// Returns the new offset 'n', as well as the slice of 64-bit floats, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalFloat64SliceIntoLimited(l *DecodeLimits, n int, b []byte, dst []float64) (int, []float64, error) {
+	return unmarshalFixedSlice(l, n, b, dst, UnmarshalFloat64)
+}
@@ -0,0 +1,144 @@
+package bstd
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/deneonet/benc"
+)
+
+// Marshals 'slice' with the fixed-width slice functions and compares the result with MarshalSlice.
+func testFixedSlice[T fixedNumber](t *testing.T, slice []T, size int,
+	marshal func(int, []byte, []T) int, appendSlice func([]byte, []T) []byte,
+	unmarshalInto func(*DecodeLimits, int, []byte, []T) (int, []T, error),
+	marshaler MarshalFunc[T], unmarshaler UnmarshalFunc[T]) {
+	t.Helper()
+
+	s := SizeFixedSlice(slice, size)
+	want := make([]byte, s)
+	MarshalSlice(0, want, slice, marshaler)
+
+	buf := make([]byte, s)
+	if n := marshal(0, buf, slice); n != s || !bytes.Equal(buf, want) {
+		t.Fatalf("marshal: no match: n %d\norg %v\ndec %v", n, want, buf)
+	}
+	if b := appendSlice([]byte{42}, slice); !bytes.Equal(b[1:], want) {
+		t.Fatalf("append: no match\norg %v\ndec %v", want, b[1:])
+	}
+
+	n, retSlice, err := unmarshalInto(nil, 0, buf, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if n != s || !reflect.DeepEqual(retSlice, slice) {
+		t.Fatalf("unmarshal: no match: n %d\norg %v\ndec %v", n, slice, retSlice)
+	}
+
+	_, genericSlice, err := UnmarshalSliceFunc(0, buf, unmarshaler)
+	if err != nil || !reflect.DeepEqual(genericSlice, retSlice) {
+		t.Fatalf("unmarshal: no match with UnmarshalSliceFunc: %v", err)
+	}
+
+	// Reuses 'dst'
+	dst := make([]T, len(slice)+1, len(slice)+2)
+	dst[len(slice)] = 1
+	_, retSlice, err = unmarshalInto(nil, 0, buf, dst)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retSlice, slice) || &retSlice[:1][0] != &dst[0] || dst[len(slice)] != 0 {
+		t.Fatal("into dst: no match!")
+	}
+
+	if _, _, err = unmarshalInto(&DecodeLimits{MaxSliceLen: len(slice) - 1}, 0, buf, nil); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatalf("expected benc.ErrLimitExceeded, got %v", err)
+	}
+
+	// The body is truncated, but the header is kept
+	buf[collectionHeaderSize]++
+	if _, _, err = unmarshalInto(nil, 0, buf, nil); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+}
+
+func TestFixedSlices(t *testing.T) {
+	testFixedSlice(t, []int16{math.MinInt16, -1, 0, 1, math.MaxInt16}, SizeInt16(),
+		MarshalInt16Slice, AppendInt16Slice, UnmarshalInt16SliceIntoLimited, MarshalInt16, UnmarshalInt16)
+	testFixedSlice(t, []uint16{0, 1, 0x0101, math.MaxUint16}, SizeUint16(),
+		MarshalUint16Slice, AppendUint16Slice, UnmarshalUint16SliceIntoLimited, MarshalUint16, UnmarshalUint16)
+	testFixedSlice(t, []int32{math.MinInt32, -1, 0, 1, math.MaxInt32}, SizeInt32(),
+		MarshalInt32Slice, AppendInt32Slice, UnmarshalInt32SliceIntoLimited, MarshalInt32, UnmarshalInt32)
+	testFixedSlice(t, []uint32{0, 1, 0x01010101, math.MaxUint32}, SizeUint32(),
+		MarshalUint32Slice, AppendUint32Slice, UnmarshalUint32SliceIntoLimited, MarshalUint32, UnmarshalUint32)
+	testFixedSlice(t, []int64{math.MinInt64, -1, 0, 1, math.MaxInt64}, SizeInt64(),
+		MarshalInt64Slice, AppendInt64Slice, UnmarshalInt64SliceIntoLimited, MarshalInt64, UnmarshalInt64)
+	testFixedSlice(t, []uint64{0, 1, 0x0101010101010101, math.MaxUint64}, SizeUint64(),
+		MarshalUint64Slice, AppendUint64Slice, UnmarshalUint64SliceIntoLimited, MarshalUint64, UnmarshalUint64)
+	testFixedSlice(t, []float32{-math.MaxFloat32, -1.5, 0, math.SmallestNonzeroFloat32, float32(math.Inf(1))}, SizeFloat32(),
+		MarshalFloat32Slice, AppendFloat32Slice, UnmarshalFloat32SliceIntoLimited, MarshalFloat32, UnmarshalFloat32)
+	testFixedSlice(t, []float64{-math.MaxFloat64, -1.5, 0, math.SmallestNonzeroFloat64, math.Inf(-1)}, SizeFloat64(),
+		MarshalFloat64Slice, AppendFloat64Slice, UnmarshalFloat64SliceIntoLimited, MarshalFloat64, UnmarshalFloat64)
+}
+
+func TestFixedSlicesEmptyAndLegacy(t *testing.T) {
+	buf := make([]byte, SizeFixedSlice([]int64{}, SizeInt64()))
+	MarshalInt64Slice(0, buf, nil)
+
+	n, retSlice, err := UnmarshalInt64Slice(0, buf)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if n != len(buf) || len(retSlice) != 0 {
+		t.Fatalf("empty: no match: n %d, dec %v", n, retSlice)
+	}
+
+	// v1: varint count, elements, terminator
+	legacy := []byte{2, 10, 0, 20, 0, 1, 1, 1, 1}
+	n, retInt16s, err := UnmarshalInt16Slice(0, legacy)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if n != len(legacy) || !reflect.DeepEqual(retInt16s, []int16{10, 20}) {
+		t.Fatalf("legacy: no match: n %d, dec %v", n, retInt16s)
+	}
+}
+
+func BenchmarkFloat64Slice(b *testing.B) {
+	slice := make([]float64, 4096)
+	for i := range slice {
+		slice[i] = float64(i) * 1.5
+	}
+	buf := make([]byte, SizeFixedSlice(slice, SizeFloat64()))
+
+	b.Run("MarshalSlice", func(b *testing.B) {
+		b.SetBytes(int64(len(buf)))
+		for i := 0; i < b.N; i++ {
+			MarshalSlice(0, buf, slice, MarshalFloat64)
+		}
+	})
+	b.Run("MarshalFloat64Slice", func(b *testing.B) {
+		b.SetBytes(int64(len(buf)))
+		for i := 0; i < b.N; i++ {
+			MarshalFloat64Slice(0, buf, slice)
+		}
+	})
+	b.Run("UnmarshalSliceFunc", func(b *testing.B) {
+		b.SetBytes(int64(len(buf)))
+		for i := 0; i < b.N; i++ {
+			if _, _, err := UnmarshalSliceFunc(0, buf, UnmarshalFloat64); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("UnmarshalFloat64Slice", func(b *testing.B) {
+		b.SetBytes(int64(len(buf)))
+		for i := 0; i < b.N; i++ {
+			if _, _, err := UnmarshalFloat64Slice(0, buf); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
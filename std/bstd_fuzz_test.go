@@ -0,0 +1,140 @@
+package bstd
+
+import (
+	"testing"
+)
+
+// Every exported decode function has to return an error, instead of panicking, for arbitrary bytes.
+// Inputs, that once panicked, are kept in testdata/fuzz as a regression corpus.
+
+var fuzzLimits = &DecodeLimits{MaxSliceLen: 1 << 16, MaxMapLen: 1 << 16, MaxBytesLen: 1 << 20, MaxAlloc: 1 << 24}
+
+func fuzzDecoders() []func(n int, b []byte) (int, error) {
+	skip := func(f func(n int, b []byte) (int, error)) func(n int, b []byte) (int, error) { return f }
+	unmarshal := func(f func(n int, b []byte) (int, any, error)) func(n int, b []byte) (int, error) {
+		return func(n int, b []byte) (int, error) {
+			n, _, err := f(n, b)
+			return n, err
+		}
+	}
+	wrap := func(n int, v any, err error) (int, any, error) { return n, v, err }
+
+	return []func(n int, b []byte) (int, error){
+		skip(SkipBool), skip(SkipByte), skip(SkipBytes), skip(SkipString), skip(SkipDuration), skip(SkipTime),
+		skip(SkipFloat32), skip(SkipFloat64), skip(SkipInt16), skip(SkipInt32), skip(SkipInt64),
+		skip(SkipUint16), skip(SkipUint32), skip(SkipUint64), skip(SkipVarint), skip(SkipVarint32), skip(SkipVarint64),
+		skip(SkipSlice), skip(SkipMap), skip(SkipBoolSlice), skip(SkipGorillaSlice),
+
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalBool(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalByte(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalBytesCopied(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalBytesCropped(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalString(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalUnsafeString(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalTime(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalDuration(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalFloat32(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalFloat64(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalInt(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalInt16(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalInt32(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalInt64(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalUint(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalUint16(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalUint32(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalUint64(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalVarint32(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalVarint64(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalUvarint32(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalUvarint64(n, b)) }),
+
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalSliceLimited[string](fuzzLimits, n, b, UnmarshalString))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalSliceLimited[[]int32](fuzzLimits, n, b, func(n int, b []byte) (int, []int32, error) {
+				return UnmarshalSliceLimited[int32](fuzzLimits, n, b, UnmarshalInt32)
+			}))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalMapLimited[string, int64](fuzzLimits, n, b, UnmarshalString, UnmarshalInt64))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalMapIntoLimited(fuzzLimits, n, b, map[int32]string{1: "a"}, UnmarshalInt32, UnmarshalString))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalSliceIntoLimited(fuzzLimits, n, b, make([]uint16, 2, 8), ToUnmarshalIntoFunc(UnmarshalUint16)))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalBoolSliceLimited(fuzzLimits, n, b))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalDeltaSliceLimited[int16](fuzzLimits, n, b))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalGorillaSliceLimited(fuzzLimits, n, b))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalInt16SliceLimited(fuzzLimits, n, b))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalUint32SliceIntoLimited(fuzzLimits, n, b, make([]uint32, 1, 4)))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalFloat64SliceLimited(fuzzLimits, n, b))
+		}),
+
+		// Without limits, a count may still not allocate more than the bytes left allow
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalSlice[string](n, b, UnmarshalString)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			return wrap(UnmarshalMap[int16, []byte](n, b, UnmarshalInt16, UnmarshalBytesCopied))
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalBoolSlice(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalDeltaSlice[uint64](n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalGorillaSlice(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalFloat32Slice(n, b)) }),
+		unmarshal(func(n int, b []byte) (int, any, error) { return wrap(UnmarshalUint64Slice(n, b)) }),
+
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			end, it, err := IterSlice(n, b, UnmarshalString)
+			if err != nil {
+				return 0, nil, err
+			}
+			for range it.All() {
+			}
+			return end, nil, it.Err()
+		}),
+		unmarshal(func(n int, b []byte) (int, any, error) {
+			end, it, err := IterMap(n, b, UnmarshalUint16, UnmarshalBytesCropped)
+			if err != nil {
+				return 0, nil, err
+			}
+			for range it.All() {
+			}
+			return end, nil, it.Err()
+		}),
+	}
+}
+
+func FuzzDecode(f *testing.F) {
+	f.Add(uint(0), AppendString(nil, "Hello World!"))
+	f.Add(uint(0), AppendSlice(nil, []string{"a", "bc"}, AppendString))
+	f.Add(uint(0), AppendMap(nil, map[string]int64{"a": 1}, AppendString, AppendInt64))
+	f.Add(uint(0), AppendDeltaSlice(nil, []int16{1, 2, 300}))
+	f.Add(uint(0), AppendGorillaSlice(nil, []float64{1, 1.5, 1.5, 2}))
+	f.Add(uint(0), AppendBoolSlice(nil, []bool{true, false, true}))
+	f.Add(uint(1), []byte{0, 0x80, 0, 3, 0, 0, 0, 1, 1, 1})
+	f.Add(uint(0), []byte{1, 1, 1, 1})
+
+	decoders := fuzzDecoders()
+	f.Fuzz(func(t *testing.T, n uint, b []byte) {
+		n %= uint(len(b)) + 1
+
+		for i, decode := range decoders {
+			tn, err := decode(int(n), b)
+			if err == nil && (tn < int(n) || tn > len(b)) {
+				t.Fatalf("decoder %d: offset %d out of [%d, %d]", i, tn, n, len(b))
+			}
+		}
+	})
+}
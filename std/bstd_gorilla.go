@@ -0,0 +1,262 @@
+package bstd
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/deneonet/benc"
+)
+
+// A gorilla slice is marshalled with the collection header and the element count, like MarshalSlice does,
+// followed by the floats, XOR compressed like in Facebook's Gorilla paper:
+//
+//   - the first float is stored as its 64 bits
+//   - '0', if a float equals the previous float
+//   - '10' + the meaningful bits of the XOR with the previous float, if they fit into the previous window
+//   - '11' + 5 bits leading zeros + 6 bits length (64 is stored as 0) + the meaningful bits of the XOR
+//
+// The bits are written from the highest bit of every byte to the lowest, the last byte is filled up with zeros.
+// Slowly changing series, like sensor readings, need a lot less than 8 bytes per float.
+
+// Writes bits, from the highest bit of every byte to the lowest.
+// A bitWriter without a buffer, that doesn't grow, only counts the bits.
+type bitWriter struct {
+	b    []byte
+	bits int
+	// Appends the bytes to 'b', when they are needed, instead of writing into a 'b' of the final size
+	grow bool
+}
+
+// Writes the lowest 'n' bits of 'v'.
+func (w *bitWriter) writeBits(v uint64, n int) {
+	if w.b == nil && !w.grow {
+		w.bits += n
+		return
+	}
+
+	for n > 0 {
+		if w.grow && w.bits/8 == len(w.b) {
+			w.b = append(w.b, 0)
+		}
+
+		free := 8 - w.bits%8
+		c := min(free, n)
+
+		n -= c
+		w.b[w.bits/8] |= byte(v>>n&(1<<c-1)) << (free - c)
+		w.bits += c
+	}
+}
+
+// Reads bits, written by a bitWriter.
+type bitReader struct {
+	b    []byte
+	bits int
+}
+
+// Returns the next 'n' bits, false, if there are less than 'n' bits left.
+func (r *bitReader) readBits(n int) (uint64, bool) {
+	if n > len(r.b)*8-r.bits {
+		return 0, false
+	}
+
+	var v uint64
+	for n > 0 {
+		left := 8 - r.bits%8
+		c := min(left, n)
+
+		n -= c
+		v = v<<c | uint64(r.b[r.bits/8]>>(left-c)&(1<<c-1))
+		r.bits += c
+	}
+	return v, true
+}
+
+// Writes the XOR compressed floats, see the description above.
+func encodeGorilla(w *bitWriter, slice []float64) {
+	if len(slice) == 0 {
+		return
+	}
+
+	prev := math.Float64bits(slice[0])
+	w.writeBits(prev, 64)
+
+	// The window of the meaningful bits, leading > 64 means no window yet
+	leading, trailing := 65, 0
+	for _, f := range slice[1:] {
+		v := math.Float64bits(f)
+		xor := v ^ prev
+		prev = v
+
+		if xor == 0 {
+			w.writeBits(0, 1)
+			continue
+		}
+
+		l := min(bits.LeadingZeros64(xor), 31)
+		t := bits.TrailingZeros64(xor)
+
+		if l >= leading && t >= trailing {
+			w.writeBits(0b10, 2)
+			w.writeBits(xor>>trailing, 64-leading-trailing)
+			continue
+		}
+
+		leading, trailing = l, t
+		sig := 64 - l - t
+		w.writeBits(0b11, 2)
+		w.writeBits(uint64(l), 5)
+		w.writeBits(uint64(sig&63), 6)
+		w.writeBits(xor>>t, sig)
+	}
+}
+
+// Returns the new offset 'n' after skipping the marshalled gorilla slice.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'buf' was too small to skip the marshalled gorilla slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func SkipGorillaSlice(n int, b []byte) (int, error) {
+	return skipCollection(n, b)
+}
+
+// Returns the bytes needed to marshal the gorilla slice.
+func SizeGorillaSlice(slice []float64) int {
+	var w bitWriter
+	encodeGorilla(&w, slice)
+	return collectionHeaderSize + SizeUint(uint(len(slice))) + (w.bits+7)/8
+}
+
+// Returns the new offset 'n' after marshalling the gorilla slice.
+//
+// !- Panics, if 'b' is too small.
+func MarshalGorillaSlice(n int, b []byte, slice []float64) int {
+	start := reserveCollectionHeader(n, b)
+	n = MarshalUint(start, b, uint(len(slice)))
+
+	var c bitWriter
+	encodeGorilla(&c, slice)
+
+	u := b[n : n+(c.bits+7)/8]
+	clear(u)
+	encodeGorilla(&bitWriter{b: u}, slice)
+	return finishCollectionHeader(start, n+len(u), b)
+}
+
+// Appends the marshalled gorilla slice to 'b' and returns the extended buffer.
+func AppendGorillaSlice(b []byte, slice []float64) []byte {
+	b = append(b, collectionMarker0, collectionMarker1, 0, 0, 0, 0)
+	start := len(b)
+
+	b = AppendUint(b, uint(len(slice)))
+
+	w := bitWriter{b: b, bits: len(b) * 8, grow: true}
+	encodeGorilla(&w, slice)
+
+	finishCollectionHeader(start, len(w.b), w.b)
+	return w.b
+}
+
+// Returns the new offset 'n', as well as the gorilla slice, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer or the bits of a float overflowed 64 bits.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the gorilla slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalGorillaSlice(n int, b []byte) (int, []float64, error) {
+	return UnmarshalGorillaSliceIntoLimited(nil, n, b, nil)
+}
+
+// Returns the new offset 'n', as well as the gorilla slice, that got unmarshalled, see UnmarshalGorillaSlice.
+// The slice is only allocated, if it stays within the limits 'l', see DecodeLimits.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer or the bits of a float overflowed 64 bits.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the gorilla slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalGorillaSliceLimited(l *DecodeLimits, n int, b []byte) (int, []float64, error) {
+	return UnmarshalGorillaSliceIntoLimited(l, n, b, nil)
+}
+
+// Returns the new offset 'n', as well as the gorilla slice, that got unmarshalled into 'dst', see UnmarshalSliceIntoLimited.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer or the bits of a float overflowed 64 bits.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the gorilla slice.
+//   - benc.ErrLimitExceeded     - the slice exceeded the limits 'l'.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalGorillaSliceIntoLimited(l *DecodeLimits, n int, b []byte, dst []float64) (int, []float64, error) {
+	n, end, err := unmarshalCollectionHeader(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	n, us, err := UnmarshalUint(n, b[:end])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Every float, except the first, needs at least one bit
+	ts, err := sliceInto(l, n, us, min(bitsIn(end-n), math.MaxUint-1)+1, dst)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(ts) == 0 {
+		return end, ts, nil
+	}
+
+	r := bitReader{b: b[n:end]}
+	bufTooSmall := func() (int, []float64, error) {
+		return 0, nil, benc.NewDecodeError(n+r.bits/8, "gorilla slice", benc.ErrBufTooSmall)
+	}
+
+	prev, ok := r.readBits(64)
+	if !ok {
+		return bufTooSmall()
+	}
+	ts[0] = math.Float64frombits(prev)
+
+	leading, trailing := 0, 0
+	for i := 1; i < len(ts); i++ {
+		var c uint64
+		if c, ok = r.readBits(1); !ok {
+			return bufTooSmall()
+		}
+
+		if c == 1 {
+			if c, ok = r.readBits(1); !ok {
+				return bufTooSmall()
+			}
+
+			if c == 1 {
+				tn := n + r.bits/8
+
+				lz, ok1 := r.readBits(5)
+				sig, ok2 := r.readBits(6)
+				if !ok1 || !ok2 {
+					return bufTooSmall()
+				}
+				if sig == 0 {
+					sig = 64
+				}
+				if lz+sig > 64 {
+					return 0, nil, benc.NewDecodeError(tn, "gorilla slice", benc.ErrOverflow)
+				}
+				leading, trailing = int(lz), 64-int(lz)-int(sig)
+			}
+
+			var xor uint64
+			if xor, ok = r.readBits(64 - leading - trailing); !ok {
+				return bufTooSmall()
+			}
+			prev ^= xor << trailing
+		}
+		ts[i] = math.Float64frombits(prev)
+	}
+	return end, ts, nil
+}
@@ -0,0 +1,130 @@
+package bstd
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/deneonet/benc"
+)
+
+func testGorillaSlice(t *testing.T, slice []float64) {
+	t.Helper()
+
+	s := SizeGorillaSlice(slice)
+	buf := make([]byte, s)
+	for i := range buf {
+		buf[i] = 0xff
+	}
+	if n := MarshalGorillaSlice(0, buf, slice); n != s {
+		t.Fatalf("%v: n %d, want %d", slice, n, s)
+	}
+	if b := AppendGorillaSlice([]byte{42}, slice); !bytes.Equal(b[1:], buf) {
+		t.Fatalf("%v: append: no match\norg %v\ndec %v", slice, buf, b[1:])
+	}
+	// The spare capacity is not zeroed
+	if b := AppendGorillaSlice(bytes.Repeat([]byte{0xff}, s+8)[:1], slice); !bytes.Equal(b[1:], buf) {
+		t.Fatalf("%v: append into capacity: no match\norg %v\ndec %v", slice, buf, b[1:])
+	}
+
+	if err := SkipOnce_Verify(buf, SkipGorillaSlice); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	n, retSlice, err := UnmarshalGorillaSlice(0, buf)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if n != s || len(retSlice) != len(slice) {
+		t.Fatalf("no match: n %d\norg %v\ndec %v", n, slice, retSlice)
+	}
+
+	// Compares the bits, so NaNs are compared as well
+	for i := range slice {
+		if math.Float64bits(retSlice[i]) != math.Float64bits(slice[i]) {
+			t.Fatalf("no match at %d\norg %v\ndec %v", i, slice, retSlice)
+		}
+	}
+}
+
+func TestGorillaSlices(t *testing.T) {
+	testGorillaSlice(t, []float64{})
+	testGorillaSlice(t, []float64{math.Pi})
+	testGorillaSlice(t, []float64{21.5, 21.5, 21.5, 21.6, 21.6, 21.7, 21.5, 21.5})
+	testGorillaSlice(t, []float64{0, math.Copysign(0, -1), math.NaN(), math.Inf(1), math.Inf(-1), math.MaxFloat64, math.SmallestNonzeroFloat64, -1})
+	testGorillaSlice(t, []float64{1, math.Float64frombits(math.Float64bits(1) ^ 1), math.Float64frombits(math.Float64bits(1) ^ 1<<63)})
+
+	r := rand.New(rand.NewSource(1))
+	random := make([]float64, 1000)
+	for i := range random {
+		random[i] = math.Float64frombits(r.Uint64())
+	}
+	testGorillaSlice(t, random)
+
+	walk := make([]float64, 1000)
+	for i := 1; i < len(walk); i++ {
+		walk[i] = walk[i-1] + float64(r.Intn(5)-2)*0.25
+	}
+	testGorillaSlice(t, walk)
+}
+
+func TestGorillaSliceSize(t *testing.T) {
+	slice := make([]float64, 1000)
+	for i := range slice {
+		slice[i] = 20 + float64(i/10)*0.5
+	}
+
+	if s, fixed := SizeGorillaSlice(slice), SizeFixedSlice(slice, SizeFloat64()); s*4 > fixed {
+		t.Fatalf("expected a slowly changing series to be compressed, got %d bytes, fixed %d bytes", s, fixed)
+	}
+}
+
+func TestGorillaSliceErrors(t *testing.T) {
+	slice := []float64{1.5, 2.5, 2.5, 3.75}
+	buf := make([]byte, SizeGorillaSlice(slice))
+	MarshalGorillaSlice(0, buf, slice)
+
+	if _, _, err := UnmarshalGorillaSlice(0, buf[:len(buf)-1]); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+	if _, err := SkipGorillaSlice(0, buf[:len(buf)-1]); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+	if _, _, err := UnmarshalGorillaSliceLimited(&DecodeLimits{MaxSliceLen: 3}, 0, buf); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatalf("expected benc.ErrLimitExceeded, got %v", err)
+	}
+
+	// A huge count doesn't allocate
+	huge := make([]byte, SizeUint(1<<30)+collectionHeaderSize)
+	finishCollectionHeader(reserveCollectionHeader(0, huge), MarshalUint(collectionHeaderSize, huge, 1<<30), huge)
+	if _, _, err := UnmarshalGorillaSlice(0, huge); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+
+	// 31 leading zeros + 40 meaningful bits overflow 64 bits
+	var w bitWriter
+	w.b = make([]byte, 10)
+	w.writeBits(0, 64)
+	w.writeBits(0b11, 2)
+	w.writeBits(31, 5)
+	w.writeBits(40, 6)
+	overflow := make([]byte, collectionHeaderSize+1+len(w.b))
+	n := MarshalUint(reserveCollectionHeader(0, overflow), overflow, 2)
+	copy(overflow[n:], w.b)
+	finishCollectionHeader(collectionHeaderSize, len(overflow), overflow)
+	if _, _, err := UnmarshalGorillaSlice(0, overflow); !errors.Is(err, benc.ErrOverflow) {
+		t.Fatalf("expected benc.ErrOverflow, got %v", err)
+	}
+
+	// Reuses 'dst'
+	dst := make([]float64, 0, 4)
+	_, retSlice, err := UnmarshalGorillaSliceIntoLimited(nil, 0, buf, dst)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(retSlice) != len(slice) || retSlice[3] != slice[3] || &retSlice[0] != &dst[:1][0] {
+		t.Fatal("into dst: no match!")
+	}
+}
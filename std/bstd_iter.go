@@ -0,0 +1,179 @@
+package bstd
+
+import (
+	"iter"
+
+	"github.com/deneonet/benc"
+)
+
+// SliceIter decodes the elements of a marshalled slice on demand, straight from the buffer, see IterSlice.
+// A nil *SliceIter is an empty slice.
+type SliceIter[T any] struct {
+	b           []byte
+	n           int
+	len         int
+	unmarshaler UnmarshalFunc[T]
+	err         error
+}
+
+// Returns the new offset 'n' after the marshalled slice, as well as a iterator over its elements,
+// that are unmarshalled using 'unmarshaler', only when they are iterated over.
+// Slices marshalled without the collection header (v1) are supported as well.
+//
+// Errors of the elements are not returned here, check SliceIter.Err after iterating.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the slice.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func IterSlice[T any](n int, b []byte, unmarshaler UnmarshalFunc[T]) (int, *SliceIter[T], error) {
+	start, end, s, err := iterCollection(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+	return end, &SliceIter[T]{b: b[:end], n: start, len: s, unmarshaler: unmarshaler}, nil
+}
+
+// Returns the element count of the slice.
+func (it *SliceIter[T]) Len() int {
+	if it == nil {
+		return 0
+	}
+	return it.len
+}
+
+// Returns the error, that stopped the last iteration, or nil.
+func (it *SliceIter[T]) Err() error {
+	if it == nil {
+		return nil
+	}
+	return it.err
+}
+
+// Returns a iterator over the indices and the elements of the slice.
+// Every iteration unmarshals the elements again, the iteration stops at the first error, see SliceIter.Err.
+func (it *SliceIter[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if it == nil {
+			return
+		}
+		it.err = nil
+
+		n := it.n
+		for i := range it.len {
+			var t T
+			if n, t, it.err = it.unmarshaler(n, it.b); it.err != nil {
+				return
+			}
+			if !yield(i, t) {
+				return
+			}
+		}
+	}
+}
+
+// Returns a iterator over the elements of the slice, see SliceIter.All.
+func (it *SliceIter[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, t := range it.All() {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// MapIter decodes the entries of a marshalled map on demand, straight from the buffer, see IterMap.
+// A nil *MapIter is an empty map.
+type MapIter[K comparable, V any] struct {
+	b            []byte
+	n            int
+	len          int
+	kUnmarshaler UnmarshalFunc[K]
+	vUnmarshaler UnmarshalFunc[V]
+	err          error
+}
+
+// Returns the new offset 'n' after the marshalled map, as well as a iterator over its entries,
+// that are unmarshalled using 'kUnmarshaler' and 'vUnmarshaler', only when they are iterated over.
+// Maps marshalled without the collection header (v1) are supported as well.
+//
+// Errors of the entries are not returned here, check MapIter.Err after iterating.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the map.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func IterMap[K comparable, V any](n int, b []byte, kUnmarshaler UnmarshalFunc[K], vUnmarshaler UnmarshalFunc[V]) (int, *MapIter[K, V], error) {
+	start, end, s, err := iterCollection(n, b)
+	if err != nil {
+		return 0, nil, err
+	}
+	return end, &MapIter[K, V]{b: b[:end], n: start, len: s, kUnmarshaler: kUnmarshaler, vUnmarshaler: vUnmarshaler}, nil
+}
+
+// Returns the entry count of the map.
+func (it *MapIter[K, V]) Len() int {
+	if it == nil {
+		return 0
+	}
+	return it.len
+}
+
+// Returns the error, that stopped the last iteration, or nil.
+func (it *MapIter[K, V]) Err() error {
+	if it == nil {
+		return nil
+	}
+	return it.err
+}
+
+// Returns a iterator over the entries of the map, in the order they got marshalled in.
+// Every iteration unmarshals the entries again, the iteration stops at the first error, see MapIter.Err.
+func (it *MapIter[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if it == nil {
+			return
+		}
+		it.err = nil
+
+		n := it.n
+		for range it.len {
+			var k K
+			var v V
+			if n, k, it.err = it.kUnmarshaler(n, it.b); it.err != nil {
+				return
+			}
+			if n, v, it.err = it.vUnmarshaler(n, it.b); it.err != nil {
+				return
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Returns the offset of the first element, the offset the collection at 'n' ends at, as well as its element count.
+func iterCollection(n int, b []byte) (int, int, int, error) {
+	end, err := skipCollection(n, b)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	if hasCollectionHeader(n, b) {
+		n += collectionHeaderSize
+	}
+
+	n, s, err := UnmarshalUint(n, b[:end])
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	// Every element takes at least one byte, so Len can't report more elements, than there are bytes
+	if s > uint(end-n) {
+		return 0, 0, 0, benc.NewDecodeError(n, "collection", benc.ErrBufTooSmall)
+	}
+	return n, end, int(s), nil
+}
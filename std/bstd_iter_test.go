@@ -0,0 +1,128 @@
+package bstd
+
+import (
+	"errors"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/deneonet/benc"
+)
+
+func TestIterSlice(t *testing.T) {
+	slice := []string{"sliceelement1", "sliceelement2", "sliceelement3"}
+	s := SizeSlice(slice, SizeString)
+	buf := make([]byte, s+1)
+	MarshalSlice(0, buf, slice, MarshalString)
+
+	n, it, err := IterSlice(0, buf, UnmarshalString)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if n != s || it.Len() != len(slice) {
+		t.Fatalf("n %d, len %d", n, it.Len())
+	}
+
+	if retSlice := slices.Collect(it.Values()); !reflect.DeepEqual(retSlice, slice) || it.Err() != nil {
+		t.Fatalf("no match: %v, %v", retSlice, it.Err())
+	}
+
+	// Stops early, without decoding the rest
+	for i, v := range it.All() {
+		if i != 0 || v != slice[0] {
+			t.Fatalf("unexpected element %d: %s", i, v)
+		}
+		break
+	}
+
+	// A nil iterator is empty
+	var nilIt *SliceIter[string]
+	if nilIt.Len() != 0 || nilIt.Err() != nil || len(slices.Collect(nilIt.Values())) != 0 {
+		t.Fatal("nil iterator: not empty")
+	}
+}
+
+func TestIterSliceErr(t *testing.T) {
+	slice := []string{"sliceelement1", "sliceelement2"}
+	buf := make([]byte, SizeSlice(slice, SizeString))
+	MarshalSlice(0, buf, slice, MarshalString)
+
+	// The count is increased, so the last element is missing
+	buf[collectionHeaderSize]++
+
+	_, it, err := IterSlice(0, buf, UnmarshalString)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if retSlice := slices.Collect(it.Values()); !reflect.DeepEqual(retSlice, slice) {
+		t.Fatalf("no match: %v", retSlice)
+	}
+	if !errors.Is(it.Err(), benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", it.Err())
+	}
+
+	if _, _, err = IterSlice(0, buf[:4], UnmarshalString); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+
+	// A count larger than the bytes left
+	count := AppendUint(nil, math.MaxUint32)
+	buf = append([]byte{collectionMarker0, collectionMarker1, byte(len(count)), 0, 0, 0}, count...)
+	if _, _, err = IterSlice(0, buf, UnmarshalString); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+	if _, _, err = IterMap(0, buf, UnmarshalString, UnmarshalString); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+}
+
+func TestIterMap(t *testing.T) {
+	m := map[int32]string{1: "mapvalue1", -2: "mapvalue2", 3: "mapvalue3"}
+	s := SizeMap(m, SizeInt32, SizeString)
+	buf := make([]byte, s)
+	MarshalMap(0, buf, m, MarshalInt32, MarshalString)
+
+	n, it, err := IterMap(0, buf, UnmarshalInt32, UnmarshalString)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if n != s || it.Len() != len(m) {
+		t.Fatalf("n %d, len %d", n, it.Len())
+	}
+
+	if retMap := maps.Collect(it.All()); !reflect.DeepEqual(retMap, m) || it.Err() != nil {
+		t.Fatalf("no match: %v, %v", retMap, it.Err())
+	}
+
+	// The count is increased, so the last entry is missing
+	buf[collectionHeaderSize]++
+
+	_, it, _ = IterMap(0, buf, UnmarshalInt32, UnmarshalString)
+	if retMap := maps.Collect(it.All()); !reflect.DeepEqual(retMap, m) || !errors.Is(it.Err(), benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", it.Err())
+	}
+}
+
+func TestIterLegacySlicesAndMaps(t *testing.T) {
+	// v1: varint count, elements, terminator
+	slice := []byte{2, 10, 20, 1, 1, 1, 1}
+	m := []byte{1, 2, 'h', 'i', 3, 1, 1, 1, 1}
+
+	n, sliceIt, err := IterSlice(0, slice, UnmarshalByte)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if retSlice := slices.Collect(sliceIt.Values()); n != len(slice) || !reflect.DeepEqual(retSlice, []byte{10, 20}) {
+		t.Fatalf("slice: no match: n %d, dec %v", n, retSlice)
+	}
+
+	n, mapIt, err := IterMap(0, m, UnmarshalString, UnmarshalByte)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if retMap := maps.Collect(mapIt.All()); n != len(m) || !reflect.DeepEqual(retMap, map[string]byte{"hi": 3}) {
+		t.Fatalf("map: no match: n %d, dec %v", n, retMap)
+	}
+}
@@ -0,0 +1,147 @@
+package bstd
+
+import (
+	"github.com/deneonet/benc"
+)
+
+// DecodeLimits restricts, how much memory unmarshalling untrusted input may allocate.
+// Every limit, that is zero ( 0 ), is not checked. A nil *DecodeLimits has no limits at all.
+//
+// The allocated bytes are summed up over all calls, that get the same *DecodeLimits,
+// so use a new DecodeLimits for every message and don't share it between goroutines.
+type DecodeLimits struct {
+	// Maximum element count of a single slice.
+	MaxSliceLen int
+	// Maximum entry count of a single map.
+	MaxMapLen int
+	// Maximum length of a single string or byte slice.
+	MaxBytesLen int
+	// Maximum bytes allocated in total, for all slices, maps, strings and byte slices.
+	MaxAlloc int
+
+	allocated int
+}
+
+// Returns the bytes allocated so far.
+func (l *DecodeLimits) Allocated() int {
+	if l == nil {
+		return 0
+	}
+	return l.allocated
+}
+
+// Adds 'count' times 'size' bytes to the allocated bytes, if 'count' doesn't exceed 'max'.
+func (l *DecodeLimits) alloc(count uint, size uintptr, max int) error {
+	if max > 0 && count > uint(max) {
+		return benc.ErrLimitExceeded
+	}
+
+	if l.MaxAlloc > 0 {
+		left := uint(l.MaxAlloc - l.allocated)
+		if size != 0 && count > left/uint(size) {
+			return benc.ErrLimitExceeded
+		}
+	}
+	l.allocated += int(count * uint(size))
+	return nil
+}
+
+func (l *DecodeLimits) allocSlice(count uint, elemSize uintptr) error {
+	if l == nil {
+		return nil
+	}
+	return l.alloc(count, elemSize, l.MaxSliceLen)
+}
+
+func (l *DecodeLimits) allocMap(count uint, entrySize uintptr) error {
+	if l == nil {
+		return nil
+	}
+	return l.alloc(count, entrySize, l.MaxMapLen)
+}
+
+// Checks the length of the marshalled string or byte slice ('typ') at 'n' against the limits.
+// 'allocates' tells, if the unmarshalled value gets allocated.
+func (l *DecodeLimits) checkBytes(n int, b []byte, typ string, allocates bool) error {
+	if l == nil {
+		return nil
+	}
+
+	_, s, err := UnmarshalUint(n, b)
+	if err != nil {
+		return err
+	}
+
+	if !allocates {
+		if l.MaxBytesLen > 0 && s > uint(l.MaxBytesLen) {
+			return benc.NewDecodeError(n, typ, benc.ErrLimitExceeded)
+		}
+		return nil
+	}
+	if err = l.alloc(s, 1, l.MaxBytesLen); err != nil {
+		return benc.NewDecodeError(n, typ, err)
+	}
+	return nil
+}
+
+// Returns the new offset 'n', as well as the string, that got unmarshalled, see UnmarshalString.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the string.
+//   - benc.ErrLimitExceeded     - the string exceeded the limits.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func (l *DecodeLimits) UnmarshalString(n int, b []byte) (int, string, error) {
+	if err := l.checkBytes(n, b, "string", true); err != nil {
+		return 0, "", err
+	}
+	return UnmarshalString(n, b)
+}
+
+// Returns the new offset 'n', as well as the string, that got unmarshalled, see UnmarshalUnsafeString.
+// The string is not allocated, so only its length is checked.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the string.
+//   - benc.ErrLimitExceeded     - the string exceeded the limits.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func (l *DecodeLimits) UnmarshalUnsafeString(n int, b []byte) (int, string, error) {
+	if err := l.checkBytes(n, b, "string", false); err != nil {
+		return 0, "", err
+	}
+	return UnmarshalUnsafeString(n, b)
+}
+
+// Returns the new offset 'n', as well as a copy of the byte slice, that got unmarshalled, see UnmarshalBytesCopied.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the byte slice.
+//   - benc.ErrLimitExceeded     - the byte slice exceeded the limits.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func (l *DecodeLimits) UnmarshalBytesCopied(n int, b []byte) (int, []byte, error) {
+	if err := l.checkBytes(n, b, "[]byte", true); err != nil {
+		return 0, nil, err
+	}
+	return UnmarshalBytesCopied(n, b)
+}
+
+// Returns the new offset 'n', as well as the cropped byte slice, that got unmarshalled, see UnmarshalBytesCropped.
+// The byte slice is not allocated, so only its length is checked.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a N-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the byte slice.
+//   - benc.ErrLimitExceeded     - the byte slice exceeded the limits.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func (l *DecodeLimits) UnmarshalBytesCropped(n int, b []byte) (int, []byte, error) {
+	if err := l.checkBytes(n, b, "[]byte", false); err != nil {
+		return 0, nil, err
+	}
+	return UnmarshalBytesCropped(n, b)
+}
@@ -0,0 +1,91 @@
+package bstd
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/deneonet/benc"
+)
+
+func TestDecodeLimits(t *testing.T) {
+	slice := []string{"sliceelement1", "sliceelement2", "sliceelement3"}
+	m := map[string]int32{"mapkey1": 1, "mapkey2": 2}
+
+	s := SizeSlice(slice, SizeString) + SizeMap(m, SizeString, SizeInt32) + SizeBytes([]byte("bytes"))
+	b := make([]byte, s)
+	n := MarshalSlice(0, b, slice, MarshalString)
+	n = MarshalMap(n, b, m, MarshalString, MarshalInt32)
+	MarshalBytes(n, b, []byte("bytes"))
+
+	unmarshal := func(l *DecodeLimits) error {
+		n, retSlice, err := UnmarshalSliceLimited[string](l, 0, b, l.UnmarshalString)
+		if err != nil {
+			return err
+		}
+		n, retMap, err := UnmarshalMapLimited[string, int32](l, n, b, l.UnmarshalString, UnmarshalInt32)
+		if err != nil {
+			return err
+		}
+		_, retBytes, err := l.UnmarshalBytesCopied(n, b)
+		if err != nil {
+			return err
+		}
+
+		if !reflect.DeepEqual(retSlice, slice) || !reflect.DeepEqual(retMap, m) || string(retBytes) != "bytes" {
+			t.Fatal("no match")
+		}
+		return nil
+	}
+
+	if err := unmarshal(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	l := &DecodeLimits{MaxSliceLen: 3, MaxMapLen: 2, MaxBytesLen: 13}
+	if err := unmarshal(l); err != nil {
+		t.Fatal(err)
+	}
+	if l.Allocated() == 0 {
+		t.Fatal("expected allocated bytes to be counted")
+	}
+
+	for _, l := range []*DecodeLimits{
+		{MaxSliceLen: 2},
+		{MaxMapLen: 1},
+		{MaxBytesLen: 12},
+		{MaxAlloc: 64},
+	} {
+		if err := unmarshal(l); !errors.Is(err, benc.ErrLimitExceeded) {
+			t.Fatalf("%+v: expected benc.ErrLimitExceeded, got %v", *l, err)
+		}
+	}
+}
+
+func TestDecodeLimitsHugeLength(t *testing.T) {
+	l := &DecodeLimits{MaxAlloc: 1 << 20}
+
+	// A slice and a map, claiming to have math.MaxUint32 elements and entries.
+	b := make([]byte, collectionHeaderSize+SizeUint(math.MaxUint32))
+	finishCollectionHeader(reserveCollectionHeader(0, b), MarshalUint(collectionHeaderSize, b, math.MaxUint32), b)
+
+	if _, _, err := UnmarshalSliceLimited[int64](l, 0, b, UnmarshalInt64); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatalf("slice: expected benc.ErrLimitExceeded, got %v", err)
+	}
+	if _, _, err := UnmarshalMapLimited[int64, int64](l, 0, b, UnmarshalInt64, UnmarshalInt64); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatalf("map: expected benc.ErrLimitExceeded, got %v", err)
+	}
+
+	b = make([]byte, SizeUint(math.MaxUint32))
+	MarshalUint(0, b, math.MaxUint32)
+	if _, _, err := l.UnmarshalString(0, b); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatalf("string: expected benc.ErrLimitExceeded, got %v", err)
+	}
+	if _, _, err := l.UnmarshalBytesCopied(0, b); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatalf("bytes: expected benc.ErrLimitExceeded, got %v", err)
+	}
+	if l.Allocated() != 0 {
+		t.Fatalf("expected no allocated bytes, got %d", l.Allocated())
+	}
+}
@@ -0,0 +1,6 @@
+//go:build !(386 || amd64 || arm || arm64 || loong64 || mips64le || mipsle || ppc64le || riscv64 || wasm) || purego
+
+package bstd
+
+// The host stores numbers big-endian (or `purego` is set), so every number is marshalled on its own.
+const nativeLittleEndian = false
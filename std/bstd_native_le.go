@@ -0,0 +1,6 @@
+//go:build (386 || amd64 || arm || arm64 || loong64 || mips64le || mipsle || ppc64le || riscv64 || wasm) && !purego
+
+package bstd
+
+// The host stores numbers little-endian, like they are marshalled.
+const nativeLittleEndian = true
@@ -0,0 +1,444 @@
+package bstd
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/deneonet/benc"
+)
+
+const defaultStreamBufSize = 4096
+
+// The bytes a decoder buffers for a single value at most, by default.
+const defaultMaxMessageSize = 64 << 20
+
+// Encoder marshals values into an internal buffer and writes them to an io.Writer.
+// The bytes written are the same as the ones produced by the `Marshal...` functions.
+//
+// Call Flush, after the last value got written.
+type Encoder struct {
+	w   io.Writer
+	buf []byte
+	err error
+}
+
+// Returns a new encoder, that writes to 'w'.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{
+		w:   w,
+		buf: make([]byte, 0, defaultStreamBufSize),
+	}
+}
+
+func (e *Encoder) flushIfFull() error {
+	if len(e.buf) >= defaultStreamBufSize {
+		return e.Flush()
+	}
+	return nil
+}
+
+// Writes all buffered bytes to the underlying io.Writer.
+//
+// Once a write failed, the error is returned by every following call on the encoder.
+func (e *Encoder) Flush() error {
+	if e.err != nil {
+		return e.err
+	}
+	if len(e.buf) == 0 {
+		return nil
+	}
+
+	n, err := e.w.Write(e.buf)
+	if n < len(e.buf) && err == nil {
+		err = io.ErrShortWrite
+	}
+	if err != nil {
+		e.err = err
+		return err
+	}
+
+	e.buf = e.buf[:0]
+	return nil
+}
+
+// Marshals 's' bytes, using 'f', into the buffer.
+// 's' is retrieved by using the benc `Size...` methods, for example `SizePlain` of generated containers.
+func (e *Encoder) Encode(s int, f func(n int, b []byte) int) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.buf = AppendWith(e.buf, s, f)
+	return e.flushIfFull()
+}
+
+func write[T any](e *Encoder, s int, marshal MarshalFunc[T], t T) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.buf = appendWith(e.buf, s, marshal, t)
+	return e.flushIfFull()
+}
+
+// Writes the string, see MarshalString.
+func (e *Encoder) WriteString(v string) error {
+	return write(e, SizeString(v), MarshalString, v)
+}
+
+// Writes the byte slice, see MarshalBytes.
+func (e *Encoder) WriteBytes(v []byte) error {
+	return write(e, SizeBytes(v), MarshalBytes, v)
+}
+
+// Writes the byte, see MarshalByte.
+func (e *Encoder) WriteByte(v byte) error {
+	return write(e, SizeByte(), MarshalByte, v)
+}
+
+// Writes the bool, see MarshalBool.
+func (e *Encoder) WriteBool(v bool) error {
+	return write(e, SizeBool(), MarshalBool, v)
+}
+
+// Writes the integer, see MarshalInt.
+func (e *Encoder) WriteInt(v int) error {
+	return write(e, SizeInt(v), MarshalInt, v)
+}
+
+// Writes the 16-bit integer, see MarshalInt16.
+func (e *Encoder) WriteInt16(v int16) error {
+	return write(e, SizeInt16(), MarshalInt16, v)
+}
+
+// Writes the 32-bit integer, see MarshalInt32.
+func (e *Encoder) WriteInt32(v int32) error {
+	return write(e, SizeInt32(), MarshalInt32, v)
+}
+
+// Writes the 64-bit integer, see MarshalInt64.
+func (e *Encoder) WriteInt64(v int64) error {
+	return write(e, SizeInt64(), MarshalInt64, v)
+}
+
+// Writes the unsigned integer, see MarshalUint.
+func (e *Encoder) WriteUint(v uint) error {
+	return write(e, SizeUint(v), MarshalUint, v)
+}
+
+// Writes the 64-bit varint, see MarshalVarint64.
+func (e *Encoder) WriteVarint64(v int64) error {
+	return write(e, SizeVarint64(v), MarshalVarint64, v)
+}
+
+// Writes the 64-bit unsigned varint, see MarshalUvarint64.
+func (e *Encoder) WriteUvarint64(v uint64) error {
+	return write(e, SizeUvarint64(v), MarshalUvarint64, v)
+}
+
+// Writes the 32-bit varint, see MarshalVarint32.
+func (e *Encoder) WriteVarint32(v int32) error {
+	return write(e, SizeVarint32(v), MarshalVarint32, v)
+}
+
+// Writes the 32-bit unsigned varint, see MarshalUvarint32.
+func (e *Encoder) WriteUvarint32(v uint32) error {
+	return write(e, SizeUvarint32(v), MarshalUvarint32, v)
+}
+
+// Writes the 16-bit unsigned integer, see MarshalUint16.
+func (e *Encoder) WriteUint16(v uint16) error {
+	return write(e, SizeUint16(), MarshalUint16, v)
+}
+
+// Writes the 32-bit unsigned integer, see MarshalUint32.
+func (e *Encoder) WriteUint32(v uint32) error {
+	return write(e, SizeUint32(), MarshalUint32, v)
+}
+
+// Writes the 64-bit unsigned integer, see MarshalUint64.
+func (e *Encoder) WriteUint64(v uint64) error {
+	return write(e, SizeUint64(), MarshalUint64, v)
+}
+
+// Writes the 32-bit float, see MarshalFloat32.
+func (e *Encoder) WriteFloat32(v float32) error {
+	return write(e, SizeFloat32(), MarshalFloat32, v)
+}
+
+// Writes the 64-bit float, see MarshalFloat64.
+func (e *Encoder) WriteFloat64(v float64) error {
+	return write(e, SizeFloat64(), MarshalFloat64, v)
+}
+
+// Writes the time, see MarshalTime.
+func (e *Encoder) WriteTime(v time.Time) error {
+	return write(e, SizeTime(), MarshalTime, v)
+}
+
+// Writes the duration, see MarshalDuration.
+func (e *Encoder) WriteDuration(v time.Duration) error {
+	return write(e, SizeDuration(), MarshalDuration, v)
+}
+
+// Writes the slice with a dynamic element size, see SizeSlice and MarshalSlice.
+func WriteSlice[T any](e *Encoder, slice []T, sizer SizeFunc[T], marshaler MarshalFunc[T]) error {
+	return e.Encode(SizeSlice(slice, sizer), func(n int, b []byte) int {
+		return MarshalSlice(n, b, slice, marshaler)
+	})
+}
+
+// Writes the slice with a fixed element size, see SizeFixedSlice and MarshalSlice.
+func WriteFixedSlice[T any](e *Encoder, slice []T, elemSize int, marshaler MarshalFunc[T]) error {
+	return e.Encode(SizeFixedSlice(slice, elemSize), func(n int, b []byte) int {
+		return MarshalSlice(n, b, slice, marshaler)
+	})
+}
+
+// Writes the map, see SizeMap and MarshalMap.
+func WriteMap[K comparable, V any](e *Encoder, m map[K]V, kSizer interface{}, vSizer interface{}, kMarshaler MarshalFunc[K], vMarshaler MarshalFunc[V]) error {
+	return e.Encode(SizeMap(m, kSizer, vSizer), func(n int, b []byte) int {
+		return MarshalMap(n, b, m, kMarshaler, vMarshaler)
+	})
+}
+
+// Decoder reads from an io.Reader into an internal buffer and unmarshals values from it.
+// It reads the bytes produced by the `Marshal...` functions, or by an Encoder.
+//
+// Unmarshal functions given to a decoder may not keep references to the buffer,
+// so `UnmarshalUnsafeString` and `UnmarshalBytesCropped` must not be used.
+type Decoder struct {
+	r       io.Reader
+	buf     []byte
+	off     int
+	err     error
+	maxSize int
+}
+
+type decoderOptFunc func(*Decoder)
+
+// A value, that needs more than 'maxSize' bytes, isn't buffered further, Decode returns benc.ErrLimitExceeded instead.
+// Without it, a hostile length makes the decoder buffer the whole stream. The default is 64 MiB, zero means no limit.
+func WithMaxMessageSize(maxSize int) decoderOptFunc {
+	return func(d *Decoder) {
+		d.maxSize = maxSize
+	}
+}
+
+// Returns a new decoder, that reads from 'r'.
+func NewDecoder(r io.Reader, opts ...decoderOptFunc) *Decoder {
+	d := &Decoder{
+		r:       r,
+		buf:     make([]byte, 0, defaultStreamBufSize),
+		maxSize: defaultMaxMessageSize,
+	}
+	for _, fn := range opts {
+		fn(d)
+	}
+	return d
+}
+
+// Reads at least 'atLeast' more bytes from the underlying io.Reader into the buffer,
+// fewer only, if the maximum message size is reached or the reader returns a error.
+func (d *Decoder) fill(atLeast int) error {
+	if d.err != nil {
+		return d.err
+	}
+
+	if d.off > 0 {
+		d.buf = d.buf[:copy(d.buf, d.buf[d.off:])]
+		d.off = 0
+	}
+
+	atLeast = max(atLeast, 1)
+	if cap(d.buf)-len(d.buf) < atLeast {
+		d.buf = slices.Grow(d.buf, max(atLeast, cap(d.buf)))
+	}
+
+	// Never buffers more than the maximum message size
+	end := cap(d.buf)
+	if d.maxSize > 0 && end > d.maxSize && len(d.buf) < d.maxSize {
+		end = d.maxSize
+	}
+	atLeast = min(atLeast, end-len(d.buf))
+
+	start := len(d.buf)
+	for noProgress := 0; len(d.buf)-start < atLeast; {
+		n, err := d.r.Read(d.buf[len(d.buf):end])
+		d.buf = d.buf[:len(d.buf)+n]
+		if err != nil {
+			d.err = err
+			if len(d.buf) > start {
+				return nil
+			}
+			return err
+		}
+
+		if n > 0 {
+			noProgress = 0
+		} else if noProgress++; noProgress == 100 {
+			d.err = io.ErrNoProgress
+			return d.err
+		}
+	}
+	return nil
+}
+
+// Unmarshals a value, using 'f', from the buffer. More bytes are read, as long as 'f' returns benc.ErrBufTooSmall,
+// up to the maximum message size, see WithMaxMessageSize. Every time, the buffered bytes of the value are at least doubled,
+// so a large value is unmarshalled a logarithmic number of times, not once per read of the underlying io.Reader.
+// 'f' is, for example, `UnmarshalPlain` of generated containers.
+//
+// Possible errors returned:
+//   - io.EOF                    - no bytes were left to unmarshal.
+//   - io.ErrUnexpectedEOF       - the reader ended in the middle of the value.
+//   - benc.ErrLimitExceeded     - the value needs more bytes than the maximum message size.
+//   - any error returned by 'f' or the underlying io.Reader.
+func (d *Decoder) Decode(f func(n int, b []byte) (int, error)) error {
+	for {
+		n, err := f(d.off, d.buf)
+		if err == nil {
+			d.off = n
+			return nil
+		}
+		if !errors.Is(err, benc.ErrBufTooSmall) {
+			return err
+		}
+		if d.maxSize > 0 && len(d.buf)-d.off >= d.maxSize {
+			return benc.NewDecodeError(d.off, "message", benc.ErrLimitExceeded)
+		}
+
+		if err = d.fill(len(d.buf) - d.off); err != nil {
+			if err == io.EOF {
+				if len(d.buf) == d.off {
+					return io.EOF
+				}
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+	}
+}
+
+func read[T any](d *Decoder, unmarshal func(n int, b []byte) (int, T, error)) (t T, err error) {
+	err = d.Decode(func(n int, b []byte) (int, error) {
+		var err error
+		n, t, err = unmarshal(n, b)
+		return n, err
+	})
+	return
+}
+
+// Reads a string, see UnmarshalString.
+func (d *Decoder) ReadString() (string, error) {
+	return read(d, UnmarshalString)
+}
+
+// Reads a byte slice, see UnmarshalBytesCopied.
+func (d *Decoder) ReadBytes() ([]byte, error) {
+	return read(d, UnmarshalBytesCopied)
+}
+
+// Reads a byte, see UnmarshalByte.
+func (d *Decoder) ReadByte() (byte, error) {
+	return read(d, UnmarshalByte)
+}
+
+// Reads a bool, see UnmarshalBool.
+func (d *Decoder) ReadBool() (bool, error) {
+	return read(d, UnmarshalBool)
+}
+
+// Reads an integer, see UnmarshalInt.
+func (d *Decoder) ReadInt() (int, error) {
+	return read(d, UnmarshalInt)
+}
+
+// Reads a 16-bit integer, see UnmarshalInt16.
+func (d *Decoder) ReadInt16() (int16, error) {
+	return read(d, UnmarshalInt16)
+}
+
+// Reads a 32-bit integer, see UnmarshalInt32.
+func (d *Decoder) ReadInt32() (int32, error) {
+	return read(d, UnmarshalInt32)
+}
+
+// Reads a 64-bit integer, see UnmarshalInt64.
+func (d *Decoder) ReadInt64() (int64, error) {
+	return read(d, UnmarshalInt64)
+}
+
+// Reads an unsigned integer, see UnmarshalUint.
+func (d *Decoder) ReadUint() (uint, error) {
+	return read(d, UnmarshalUint)
+}
+
+// Reads a 64-bit varint, see UnmarshalVarint64.
+func (d *Decoder) ReadVarint64() (int64, error) {
+	return read(d, UnmarshalVarint64)
+}
+
+// Reads a 64-bit unsigned varint, see UnmarshalUvarint64.
+func (d *Decoder) ReadUvarint64() (uint64, error) {
+	return read(d, UnmarshalUvarint64)
+}
+
+// Reads a 32-bit varint, see UnmarshalVarint32.
+func (d *Decoder) ReadVarint32() (int32, error) {
+	return read(d, UnmarshalVarint32)
+}
+
+// Reads a 32-bit unsigned varint, see UnmarshalUvarint32.
+func (d *Decoder) ReadUvarint32() (uint32, error) {
+	return read(d, UnmarshalUvarint32)
+}
+
+// Reads a 16-bit unsigned integer, see UnmarshalUint16.
+func (d *Decoder) ReadUint16() (uint16, error) {
+	return read(d, UnmarshalUint16)
+}
+
+// Reads a 32-bit unsigned integer, see UnmarshalUint32.
+func (d *Decoder) ReadUint32() (uint32, error) {
+	return read(d, UnmarshalUint32)
+}
+
+// Reads a 64-bit unsigned integer, see UnmarshalUint64.
+func (d *Decoder) ReadUint64() (uint64, error) {
+	return read(d, UnmarshalUint64)
+}
+
+// Reads a 32-bit float, see UnmarshalFloat32.
+func (d *Decoder) ReadFloat32() (float32, error) {
+	return read(d, UnmarshalFloat32)
+}
+
+// Reads a 64-bit float, see UnmarshalFloat64.
+func (d *Decoder) ReadFloat64() (float64, error) {
+	return read(d, UnmarshalFloat64)
+}
+
+// Reads a time, see UnmarshalTime.
+func (d *Decoder) ReadTime() (time.Time, error) {
+	return read(d, UnmarshalTime)
+}
+
+// Reads a duration, see UnmarshalDuration.
+func (d *Decoder) ReadDuration() (time.Duration, error) {
+	return read(d, UnmarshalDuration)
+}
+
+// Reads a slice, see UnmarshalSlice.
+func ReadSlice[T any](d *Decoder, unmarshaler interface{}) ([]T, error) {
+	return read(d, func(n int, b []byte) (int, []T, error) {
+		return UnmarshalSlice[T](n, b, unmarshaler)
+	})
+}
+
+// Reads a map, see UnmarshalMap.
+func ReadMap[K comparable, V any](d *Decoder, kUnmarshaler interface{}, vUnmarshaler interface{}) (map[K]V, error) {
+	return read(d, func(n int, b []byte) (int, map[K]V, error) {
+		return UnmarshalMap[K, V](n, b, kUnmarshaler, vUnmarshaler)
+	})
+}
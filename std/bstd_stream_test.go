@@ -0,0 +1,223 @@
+package bstd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+	"testing/iotest"
+
+	"github.com/deneonet/benc"
+)
+
+func TestStream(t *testing.T) {
+	str := "Hello World!"
+	bs := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	slice := []string{"sliceelement1", "sliceelement2", "sliceelement3"}
+	fixedSlice := []uint64{1, 2, 3}
+	m := map[string]int32{"mapkey1": 1}
+
+	s := SizeString(str) + SizeBytes(bs) + SizeByte() + SizeBool() + SizeInt(math.MinInt) + SizeInt16() + SizeInt32() + SizeInt64() +
+		SizeUint(math.MaxUint) + SizeUint16() + SizeUint32() + SizeUint64() + SizeFloat32() + SizeFloat64() +
+		SizeSlice(slice, SizeString) + SizeFixedSlice(fixedSlice, SizeUint64()) + SizeMap(m, SizeString, SizeInt32)
+	expected := make([]byte, s)
+	n := MarshalString(0, expected, str)
+	n = MarshalBytes(n, expected, bs)
+	n = MarshalByte(n, expected, 128)
+	n = MarshalBool(n, expected, true)
+	n = MarshalInt(n, expected, math.MinInt)
+	n = MarshalInt16(n, expected, -16)
+	n = MarshalInt32(n, expected, -32)
+	n = MarshalInt64(n, expected, -64)
+	n = MarshalUint(n, expected, math.MaxUint)
+	n = MarshalUint16(n, expected, 16)
+	n = MarshalUint32(n, expected, 32)
+	n = MarshalUint64(n, expected, 64)
+	n = MarshalFloat32(n, expected, 32.5)
+	n = MarshalFloat64(n, expected, 64.5)
+	n = MarshalSlice(n, expected, slice, MarshalString)
+	n = MarshalSlice(n, expected, fixedSlice, MarshalUint64)
+	MarshalMap(n, expected, m, MarshalString, MarshalInt32)
+
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	for _, err := range []error{
+		e.WriteString(str),
+		e.WriteBytes(bs),
+		e.WriteByte(128),
+		e.WriteBool(true),
+		e.WriteInt(math.MinInt),
+		e.WriteInt16(-16),
+		e.WriteInt32(-32),
+		e.WriteInt64(-64),
+		e.WriteUint(math.MaxUint),
+		e.WriteUint16(16),
+		e.WriteUint32(32),
+		e.WriteUint64(64),
+		e.WriteFloat32(32.5),
+		e.WriteFloat64(64.5),
+		WriteSlice(e, slice, SizeString, MarshalString),
+		WriteFixedSlice(e, fixedSlice, SizeUint64(), MarshalUint64),
+		WriteMap(e, m, SizeString, SizeInt32, MarshalString, MarshalInt32),
+		e.Flush(),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !bytes.Equal(buf.Bytes(), expected) {
+		t.Fatalf("no match\nstream: %v\noffset: %v", buf.Bytes(), expected)
+	}
+
+	readers := map[string]io.Reader{
+		"full":     bytes.NewReader(expected),
+		"one byte": iotest.OneByteReader(bytes.NewReader(expected)),
+		"half":     iotest.HalfReader(bytes.NewReader(expected)),
+		"data err": iotest.DataErrReader(bytes.NewReader(expected)),
+	}
+
+	for name, r := range readers {
+		t.Run(name, func(t *testing.T) {
+			d := NewDecoder(r)
+			values := []any{str, bs, byte(128), true, int(math.MinInt), int16(-16), int32(-32), int64(-64), uint(math.MaxUint), uint16(16), uint32(32), uint64(64), float32(32.5), float64(64.5), slice, fixedSlice, m}
+			reads := []func() (any, error){
+				func() (any, error) { return d.ReadString() },
+				func() (any, error) { return d.ReadBytes() },
+				func() (any, error) { return d.ReadByte() },
+				func() (any, error) { return d.ReadBool() },
+				func() (any, error) { return d.ReadInt() },
+				func() (any, error) { return d.ReadInt16() },
+				func() (any, error) { return d.ReadInt32() },
+				func() (any, error) { return d.ReadInt64() },
+				func() (any, error) { return d.ReadUint() },
+				func() (any, error) { return d.ReadUint16() },
+				func() (any, error) { return d.ReadUint32() },
+				func() (any, error) { return d.ReadUint64() },
+				func() (any, error) { return d.ReadFloat32() },
+				func() (any, error) { return d.ReadFloat64() },
+				func() (any, error) { return ReadSlice[string](d, UnmarshalString) },
+				func() (any, error) { return ReadSlice[uint64](d, UnmarshalUint64) },
+				func() (any, error) { return ReadMap[string, int32](d, UnmarshalString, UnmarshalInt32) },
+			}
+
+			for i, read := range reads {
+				v, err := read()
+				if err != nil {
+					t.Fatalf("at idx %d: %s", i, err)
+				}
+				if !reflect.DeepEqual(v, values[i]) {
+					t.Fatalf("at idx %d: no match: expected %v, got %v", i, values[i], v)
+				}
+			}
+
+			if _, err := d.ReadByte(); err != io.EOF {
+				t.Fatalf("expected io.EOF, got %v", err)
+			}
+		})
+	}
+}
+
+func TestStreamLargeValues(t *testing.T) {
+	slice := make([]string, 1000)
+	for i := range slice {
+		slice[i] = "sliceelement"
+	}
+
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	for i := 0; i < 3; i++ {
+		if err := WriteSlice(e, slice, SizeString, MarshalString); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := e.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewDecoder(iotest.HalfReader(&buf))
+	for i := 0; i < 3; i++ {
+		retSlice, err := ReadSlice[string](d, UnmarshalString)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(retSlice, slice) {
+			t.Fatalf("%d: no match", i)
+		}
+	}
+}
+
+func TestStreamLargeValueRetries(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	if err := e.WriteBytes(make([]byte, 1<<16)); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every read returns one byte only
+	d := NewDecoder(iotest.OneByteReader(&buf))
+	calls := 0
+	err := d.Decode(func(n int, b []byte) (int, error) {
+		calls++
+		n, _, err := UnmarshalBytesCropped(n, b)
+		return n, err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls > 32 {
+		t.Fatalf("expected the value to be unmarshalled a few times, got %d", calls)
+	}
+}
+
+// Reads zeros endlessly.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+type shortWriter struct{}
+
+func (shortWriter) Write(p []byte) (int, error) {
+	return len(p) / 2, nil
+}
+
+func TestStreamErrors(t *testing.T) {
+	d := NewDecoder(bytes.NewReader([]byte{8, 1, 2}))
+	if _, err := d.ReadString(); err != io.ErrUnexpectedEOF {
+		t.Fatalf("expected io.ErrUnexpectedEOF, got %v", err)
+	}
+
+	// A hostile length may not make the decoder buffer the whole stream
+	huge := AppendUint(nil, 1<<30)
+	d = NewDecoder(io.MultiReader(bytes.NewReader(huge), zeroReader{}), WithMaxMessageSize(1<<16))
+	if _, err := d.ReadString(); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatalf("expected benc.ErrLimitExceeded, got %v", err)
+	}
+	if len(d.buf) > 1<<16 {
+		t.Fatalf("expected at most %d bytes buffered, got %d", 1<<16, len(d.buf))
+	}
+
+	d = NewDecoder(iotest.ErrReader(iotest.ErrTimeout))
+	if _, err := d.ReadUint64(); err != iotest.ErrTimeout {
+		t.Fatalf("expected iotest.ErrTimeout, got %v", err)
+	}
+
+	e := NewEncoder(shortWriter{})
+	if err := e.WriteUint64(64); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Flush(); !errors.Is(err, io.ErrShortWrite) {
+		t.Fatalf("expected io.ErrShortWrite, got %v", err)
+	}
+	if err := e.WriteUint64(64); !errors.Is(err, io.ErrShortWrite) {
+		t.Fatalf("expected a sticky io.ErrShortWrite, got %v", err)
+	}
+}
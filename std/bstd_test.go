@@ -7,6 +7,7 @@ import (
 	"math/rand"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/deneonet/benc"
 )
@@ -99,7 +100,7 @@ func UnmarshalAll_VerifyError(expected error, buffers [][]byte, unmarshals ...fu
 	var err error
 	for i, unmarshal := range unmarshals {
 		_, _, err = unmarshal(0, buffers[i])
-		if err != expected {
+		if !errors.Is(err, expected) {
 			return fmt.Errorf("(unmarshal) at idx %d: expected a %s error", i, expected)
 		}
 	}
@@ -110,7 +111,7 @@ func SkipAll_VerifyError(expected error, buffers [][]byte, skipers ...func(n int
 	var err error
 	for i, skiper := range skipers {
 		_, err = skiper(0, buffers[i])
-		if err != expected {
+		if !errors.Is(err, expected) {
 			return fmt.Errorf("(skip) at idx %d: expected a %s error, got %s", i, expected, err)
 		}
 	}
@@ -310,6 +311,118 @@ func TestSlices(t *testing.T) {
 	}
 }
 
+func TestTypedSlices(t *testing.T) {
+	slice := []string{"sliceelement1", "sliceelement2", "sliceelement3"}
+	buf := make([]byte, SizeSlice(slice, SizeString))
+	MarshalSlice(0, buf, slice, MarshalString)
+
+	_, retSlice, err := UnmarshalSliceFunc(0, buf, UnmarshalString)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retSlice, slice) {
+		t.Fatal("func: no match!")
+	}
+
+	into := func(n int, b []byte, s *string) (int, error) {
+		var err error
+		n, *s, err = UnmarshalString(n, b)
+		return n, err
+	}
+
+	_, retSlice, err = UnmarshalSliceInto(0, buf, nil, into)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retSlice, slice) {
+		t.Fatal("into: no match!")
+	}
+
+	// The capacity of dst is reused, old elements are cleared.
+	dst := make([]string, 5, 8)
+	dst[4] = "old"
+	_, retSlice, err = UnmarshalSliceInto(0, buf, dst, into)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retSlice, slice) || &retSlice[0] != &dst[0] || dst[4] != "" {
+		t.Fatal("into dst: no match!")
+	}
+
+	_, retSlice, err = UnmarshalSliceInto(0, buf, make([]string, 0, 2), into)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retSlice, slice) {
+		t.Fatal("into small dst: no match!")
+	}
+
+	// An empty dst with enough capacity is reused as well.
+	dst = make([]string, 0, 4)
+	_, retSlice, err = UnmarshalSliceInto(0, buf, dst, into)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retSlice, slice) || &retSlice[0] != &dst[:1][0] {
+		t.Fatal("into empty dst: no match!")
+	}
+}
+
+func TestTypedMaps(t *testing.T) {
+	m := map[int32]string{1: "mapvalue1", -2: "mapvalue2", 3: "mapvalue3"}
+
+	s := SizeMapFunc(m, FixedSize[int32](SizeInt32()), SizeString)
+	if s != SizeMap(m, SizeInt32, SizeString) {
+		t.Fatal("size: no match!")
+	}
+	buf := make([]byte, s)
+	MarshalMap(0, buf, m, MarshalInt32, MarshalString)
+
+	_, retMap, err := UnmarshalMapFunc(0, buf, UnmarshalInt32, UnmarshalString)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retMap, m) {
+		t.Fatal("no match!")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for an invalid `vUnmarshaler`")
+		}
+	}()
+	UnmarshalMap[int32, string](0, buf, UnmarshalInt32, UnmarshalInt32)
+}
+
+func TestMapInto(t *testing.T) {
+	m := map[int32]string{1: "mapvalue1", -2: "mapvalue2", 3: "mapvalue3"}
+	buf := make([]byte, SizeMap(m, SizeInt32, SizeString))
+	MarshalMap(0, buf, m, MarshalInt32, MarshalString)
+
+	_, retMap, err := UnmarshalMapInto(0, buf, nil, UnmarshalInt32, UnmarshalString)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retMap, m) {
+		t.Fatal("nil dst: no match!")
+	}
+
+	// The old entries of dst are removed, dst itself is returned.
+	dst := map[int32]string{1: "old", 4: "old"}
+	_, retMap, err = UnmarshalMapInto(0, buf, dst, UnmarshalInt32, UnmarshalString)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(retMap, m) || !reflect.DeepEqual(dst, m) {
+		t.Fatal("dst: no match!")
+	}
+
+	l := DecodeLimits{MaxMapLen: 2}
+	if _, _, err = UnmarshalMapIntoLimited(&l, 0, buf, dst, UnmarshalInt32, UnmarshalString); !errors.Is(err, benc.ErrLimitExceeded) {
+		t.Fatal("expected ErrLimitExceeded")
+	}
+}
+
 func TestMaps(t *testing.T) {
 	m := make(map[string]string)
 	m["mapkey1"] = "mapvalue1"
@@ -516,3 +629,181 @@ func TestUnmarshalUint(t *testing.T) {
 		})
 	}
 }
+
+func TestSlicesWithTerminatorBytes(t *testing.T) {
+	slice := []uint32{0x01010101, 0x01010101}
+	s := SizeFixedSlice(slice, SizeUint32())
+	buf := make([]byte, s)
+	MarshalSlice(0, buf, slice, MarshalUint32)
+
+	if err := SkipOnce_Verify(buf, func(n int, b []byte) (int, error) {
+		return SkipSlice(n, b)
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, retSlice, err := UnmarshalSlice[uint32](0, buf, UnmarshalUint32)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !reflect.DeepEqual(retSlice, slice) {
+		t.Logf("org %v\ndec %v", slice, retSlice)
+		t.Fatal("no match!")
+	}
+}
+
+func TestMapsWithTerminatorBytes(t *testing.T) {
+	m := map[string]string{"\x01\x01\x01\x01": "\x01\x01\x01\x01"}
+	s := SizeMap(m, SizeString, SizeString)
+	buf := make([]byte, s)
+	MarshalMap(0, buf, m, MarshalString, MarshalString)
+
+	if err := SkipOnce_Verify(buf, func(n int, b []byte) (int, error) {
+		return SkipMap(n, b)
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, retMap, err := UnmarshalMap[string, string](0, buf, UnmarshalString, UnmarshalString)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !reflect.DeepEqual(retMap, m) {
+		t.Logf("org %v\ndec %v", m, retMap)
+		t.Fatal("no match!")
+	}
+}
+
+func TestLegacySlicesAndMaps(t *testing.T) {
+	// v1: varint count, elements, terminator
+	slice := []byte{2, 10, 20, 1, 1, 1, 1}
+	m := []byte{1, 2, 'h', 'i', 3, 1, 1, 1, 1}
+
+	if err := SkipOnce_Verify(slice, SkipSlice); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := SkipOnce_Verify(m, SkipMap); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	n, retSlice, err := UnmarshalSlice[byte](0, slice, UnmarshalByte)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if n != len(slice) || !reflect.DeepEqual(retSlice, []byte{10, 20}) {
+		t.Fatalf("slice: no match: n %d, dec %v", n, retSlice)
+	}
+
+	n, retMap, err := UnmarshalMap[string, byte](0, m, UnmarshalString, UnmarshalByte)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if n != len(m) || !reflect.DeepEqual(retMap, map[string]byte{"hi": 3}) {
+		t.Fatalf("map: no match: n %d, dec %v", n, retMap)
+	}
+}
+
+func TestCollectionHeaderErrBufTooSmall(t *testing.T) {
+	// header announces a 16 byte body, but only 1 byte follows
+	buf := []byte{0x80, 0x00, 16, 0, 0, 0, 0}
+
+	if _, err := SkipSlice(0, buf); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatal("skip: expected a benc.ErrBufTooSmall error")
+	}
+	if _, _, err := UnmarshalSlice[byte](0, buf, UnmarshalByte); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatal("unmarshal: expected a benc.ErrBufTooSmall error")
+	}
+	if _, _, err := UnmarshalMap[byte, byte](0, buf[:4], UnmarshalByte, UnmarshalByte); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatal("unmarshal map: expected a benc.ErrBufTooSmall error")
+	}
+
+	// The largest length doesn't fit into a 32-bit int, it may not move the offset backwards (GOARCH=386)
+	buf = []byte{0x80, 0x00, 0xff, 0xff, 0xff, 0xff, 0}
+	if _, err := SkipSlice(0, buf); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("skip max length: expected a benc.ErrBufTooSmall error, got %v", err)
+	}
+	if _, _, err := UnmarshalGorillaSlice(0, buf); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("gorilla max length: expected a benc.ErrBufTooSmall error, got %v", err)
+	}
+}
+
+func TestTimeAndDuration(t *testing.T) {
+	times := []time.Time{
+		{},
+		time.Unix(0, 0).UTC(),
+		time.Date(2024, 5, 17, 13, 37, 0, 123456789, time.UTC),
+		time.Date(1900, 1, 1, 0, 0, 0, 1, time.FixedZone("", -(3*3600+30*60))),
+		time.Date(2100, 12, 31, 23, 59, 59, 999999999, time.FixedZone("CEST", 2*3600)),
+	}
+
+	for _, tm := range times {
+		b := make([]byte, SizeTime())
+		if n := MarshalTime(0, b, tm); n != len(b) {
+			t.Fatalf("%v: marshal: expected offset %d, got %d", tm, len(b), n)
+		}
+
+		n, retTime, err := UnmarshalTime(0, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != len(b) {
+			t.Fatalf("%v: unmarshal: expected offset %d, got %d", tm, len(b), n)
+		}
+
+		_, offset := tm.Zone()
+		_, retOffset := retTime.Zone()
+		if !retTime.Equal(tm) || offset != retOffset {
+			t.Fatalf("no match: expected %v, got %v", tm, retTime)
+		}
+
+		if err = SkipOnce_Verify(b, SkipTime); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err = UnmarshalTime(0, b[:len(b)-1]); !errors.Is(err, benc.ErrBufTooSmall) {
+			t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+		}
+	}
+
+	b := make([]byte, SizeTime())
+	MarshalTime(0, b, time.Time{})
+	if _, retTime, _ := UnmarshalTime(0, b); !reflect.DeepEqual(retTime, time.Time{}) {
+		t.Fatalf("zero time: no match, got %#v", retTime)
+	}
+
+	for _, d := range []time.Duration{0, time.Nanosecond, -90 * time.Minute, math.MaxInt64, math.MinInt64} {
+		b := make([]byte, SizeDuration())
+		MarshalDuration(0, b, d)
+
+		_, retDuration, err := UnmarshalDuration(0, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retDuration != d {
+			t.Fatalf("no match: expected %v, got %v", d, retDuration)
+		}
+		if err = SkipOnce_Verify(b, SkipDuration); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDecodeErrorOffset(t *testing.T) {
+	b := []byte{0, 0, 0, 1, 2, 3}
+	_, _, err := UnmarshalUint64(3, b)
+
+	var de *benc.DecodeError
+	if !errors.As(err, &de) {
+		t.Fatalf("expected a *benc.DecodeError, got %T", err)
+	}
+	if de.Offset != 3 || de.Type != "uint64" || !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("unexpected decode error: %v", err)
+	}
+
+	b = AppendString([]byte{0, 0}, "too short")
+	_, _, err = UnmarshalString(2, b[:len(b)-1])
+	if !errors.As(err, &de) || de.Offset != 3 || de.Type != "string" {
+		t.Fatalf("unexpected decode error: %v", err)
+	}
+}
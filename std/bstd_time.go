@@ -0,0 +1,103 @@
+package bstd
+
+import (
+	"time"
+
+	"github.com/deneonet/benc"
+)
+
+// A time is marshalled into 16 bytes, all little-endian:
+//
+//	| int64 seconds since the Unix epoch | uint32 nanoseconds | int32 zone offset in seconds east of UTC |
+//
+// The zone name and the location are not kept, an unmarshalled time is in UTC, if the offset is zero,
+// otherwise it is in a fixed zone with the marshalled offset. The monotonic clock reading is dropped.
+const timeSize = 16
+
+// Returns the new offset 'n' after skipping the marshalled time.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'b' was too small to skip the marshalled time.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func SkipTime(n int, b []byte) (int, error) {
+	if len(b)-n < timeSize {
+		return 0, benc.NewDecodeError(n, "time.Time", benc.ErrBufTooSmall)
+	}
+	return n + timeSize, nil
+}
+
+// Returns the bytes needed to marshal a time.
+func SizeTime() int {
+	return timeSize
+}
+
+// Returns the new offset 'n' after marshalling the time.
+//
+// !- Panics, if 'b' is too small.
+func MarshalTime(n int, b []byte, t time.Time) int {
+	_, offset := t.Zone()
+	n = MarshalInt64(n, b, t.Unix())
+	n = MarshalUint32(n, b, uint32(t.Nanosecond()))
+	return MarshalInt32(n, b, int32(offset))
+}
+
+// Returns the new offset 'n', as well as the time, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'b' was too small to unmarshal the time.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalTime(n int, b []byte) (int, time.Time, error) {
+	if len(b)-n < timeSize {
+		return 0, time.Time{}, benc.NewDecodeError(n, "time.Time", benc.ErrBufTooSmall)
+	}
+
+	n, sec, _ := UnmarshalInt64(n, b)
+	n, nsec, _ := UnmarshalUint32(n, b)
+	n, offset, _ := UnmarshalInt32(n, b)
+
+	t := time.Unix(sec, int64(nsec))
+	if offset == 0 {
+		return n, t.UTC(), nil
+	}
+	return n, t.In(time.FixedZone("", int(offset))), nil
+}
+
+// A duration is marshalled as its nanoseconds, into 8 bytes, see MarshalInt64.
+
+// Returns the new offset 'n' after skipping the marshalled duration.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'b' was too small to skip the marshalled duration.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func SkipDuration(n int, b []byte) (int, error) {
+	return SkipInt64(n, b)
+}
+
+// Returns the bytes needed to marshal a duration.
+func SizeDuration() int {
+	return SizeInt64()
+}
+
+// Returns the new offset 'n' after marshalling the duration.
+//
+// !- Panics, if 'b' is too small.
+func MarshalDuration(n int, b []byte, d time.Duration) int {
+	return MarshalInt64(n, b, int64(d))
+}
+
+// Returns the new offset 'n', as well as the duration, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrBufTooSmall       - 'b' was too small to unmarshal the duration.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalDuration(n int, b []byte) (int, time.Duration, error) {
+	n, v, err := UnmarshalInt64(n, b)
+	if err != nil {
+		return 0, 0, err
+	}
+	return n, time.Duration(v), nil
+}
@@ -0,0 +1,212 @@
+package bstd
+
+import (
+	"encoding/binary"
+
+	"github.com/deneonet/benc"
+	"golang.org/x/exp/constraints"
+)
+
+// The varint families have the same limits on every architecture:
+// a 64-bit varint has at most 10 bytes, a 32-bit varint at most 5 bytes.
+// Signed varints are zigzag encoded, so small negative numbers stay small.
+//
+// A 32-bit varint is read by the 64-bit family, but not the other way around,
+// as long as the value fits into 32 bits.
+
+// Returns the new offset 'n' after skipping the marshalled varint ('typ') with 'maxLen' bytes at most,
+// where the last byte may not be greater than 'lastMax'.
+func skipVarint(n int, buf []byte, typ string, maxLen int, lastMax byte) (int, error) {
+	if n > len(buf) {
+		return 0, benc.NewDecodeError(n, typ, benc.ErrBufTooSmall)
+	}
+	for i, b := range buf[n:] {
+		if i == maxLen {
+			return 0, benc.NewDecodeError(n, typ, benc.ErrOverflow)
+		}
+		if b < 0x80 {
+			if i == maxLen-1 && b > lastMax {
+				return 0, benc.NewDecodeError(n, typ, benc.ErrOverflow)
+			}
+			return n + i + 1, nil
+		}
+	}
+	return 0, benc.NewDecodeError(n, typ, benc.ErrBufTooSmall)
+}
+
+func sizeUvarint[T constraints.Unsigned](v T) int {
+	i := 0
+	for v >= 0x80 {
+		v >>= 7
+		i++
+	}
+	return i + 1
+}
+
+func marshalUvarint[T constraints.Unsigned](n int, b []byte, v T) int {
+	i := n
+	for v >= 0x80 {
+		b[i] = byte(v) | 0x80
+		v >>= 7
+		i++
+	}
+	b[i] = byte(v)
+	return i + 1
+}
+
+func appendUvarint[T constraints.Unsigned](b []byte, v T) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+// Returns the new offset 'n', as well as the varint ('typ') with 'maxLen' bytes at most,
+// where the last byte may not be greater than 'lastMax', that got unmarshalled.
+func unmarshalUvarint[T constraints.Unsigned](n int, buf []byte, typ string, maxLen int, lastMax byte) (int, T, error) {
+	if n > len(buf) {
+		return 0, 0, benc.NewDecodeError(n, typ, benc.ErrBufTooSmall)
+	}
+
+	var x T
+	var s uint
+	for i, b := range buf[n:] {
+		if i == maxLen {
+			return 0, 0, benc.NewDecodeError(n, typ, benc.ErrOverflow)
+		}
+		if b < 0x80 {
+			if i == maxLen-1 && b > lastMax {
+				return 0, 0, benc.NewDecodeError(n, typ, benc.ErrOverflow)
+			}
+			return n + i + 1, x | T(b)<<s, nil
+		}
+		x |= T(b&0x7f) << s
+		s += 7
+	}
+	return 0, 0, benc.NewDecodeError(n, typ, benc.ErrBufTooSmall)
+}
+
+// Returns the new offset 'n' after skipping the marshalled 64-bit varint.
+// For signed and unsigned 64-bit varints.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a 64-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to skip the marshalled varint.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func SkipVarint64(n int, buf []byte) (int, error) {
+	return skipVarint(n, buf, "varint64", binary.MaxVarintLen64, 0x01)
+}
+
+// Returns the bytes needed to marshal a 64-bit unsigned varint.
+func SizeUvarint64(v uint64) int {
+	return sizeUvarint(v)
+}
+
+// Returns the new offset 'n' after marshalling the 64-bit unsigned varint.
+//
+// !- Panics, if 'b' is too small.
+func MarshalUvarint64(n int, b []byte, v uint64) int {
+	return marshalUvarint(n, b, v)
+}
+
+// Returns the new offset 'n', as well as the 64-bit unsigned varint, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a 64-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the varint.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUvarint64(n int, buf []byte) (int, uint64, error) {
+	return unmarshalUvarint[uint64](n, buf, "uvarint64", binary.MaxVarintLen64, 0x01)
+}
+
+// Returns the bytes needed to marshal a 64-bit varint.
+func SizeVarint64(sv int64) int {
+	return sizeUvarint(uint64(encodeZigZag(sv)))
+}
+
+// Returns the new offset 'n' after marshalling the 64-bit varint.
+//
+// !- Panics, if 'b' is too small.
+func MarshalVarint64(n int, b []byte, sv int64) int {
+	return marshalUvarint(n, b, uint64(encodeZigZag(sv)))
+}
+
+// Returns the new offset 'n', as well as the 64-bit varint, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a 64-bit integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the varint.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalVarint64(n int, buf []byte) (int, int64, error) {
+	n, v, err := unmarshalUvarint[uint64](n, buf, "varint64", binary.MaxVarintLen64, 0x01)
+	if err != nil {
+		return 0, 0, err
+	}
+	return n, int64(decodeZigZag(v)), nil
+}
+
+// Returns the new offset 'n' after skipping the marshalled 32-bit varint.
+// For signed and unsigned 32-bit varints.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a 32-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to skip the marshalled varint.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func SkipVarint32(n int, buf []byte) (int, error) {
+	return skipVarint(n, buf, "varint32", binary.MaxVarintLen32, 0x0f)
+}
+
+// Returns the bytes needed to marshal a 32-bit unsigned varint.
+func SizeUvarint32(v uint32) int {
+	return sizeUvarint(v)
+}
+
+// Returns the new offset 'n' after marshalling the 32-bit unsigned varint.
+//
+// !- Panics, if 'b' is too small.
+func MarshalUvarint32(n int, b []byte, v uint32) int {
+	return marshalUvarint(n, b, v)
+}
+
+// Returns the new offset 'n', as well as the 32-bit unsigned varint, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a 32-bit unsigned integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the varint.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalUvarint32(n int, buf []byte) (int, uint32, error) {
+	return unmarshalUvarint[uint32](n, buf, "uvarint32", binary.MaxVarintLen32, 0x0f)
+}
+
+// Returns the bytes needed to marshal a 32-bit varint.
+func SizeVarint32(sv int32) int {
+	return sizeUvarint(uint32(encodeZigZag(sv)))
+}
+
+// Returns the new offset 'n' after marshalling the 32-bit varint.
+//
+// !- Panics, if 'b' is too small.
+func MarshalVarint32(n int, b []byte, sv int32) int {
+	return marshalUvarint(n, b, uint32(encodeZigZag(sv)))
+}
+
+// Returns the new offset 'n', as well as the 32-bit varint, that got unmarshalled.
+//
+// Possible errors returned:
+//   - benc.ErrOverflow          - varint overflowed a 32-bit integer.
+//   - benc.ErrBufTooSmall       - 'buf' was too small to unmarshal the varint.
+//
+// If a error is returned, n (the int returned) equals zero ( 0 ).
+func UnmarshalVarint32(n int, buf []byte) (int, int32, error) {
+	n, v, err := unmarshalUvarint[uint32](n, buf, "varint32", binary.MaxVarintLen32, 0x0f)
+	if err != nil {
+		return 0, 0, err
+	}
+	return n, int32(decodeZigZag(v)), nil
+}
@@ -0,0 +1,152 @@
+package bstd
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/deneonet/benc"
+)
+
+func TestVarint64(t *testing.T) {
+	values := []int64{0, 1, -1, 63, -64, 64, math.MaxInt32, math.MinInt32, math.MaxInt64, math.MinInt64}
+	for _, v := range values {
+		s := SizeVarint64(v)
+		b := make([]byte, s)
+		if n := MarshalVarint64(0, b, v); n != s {
+			t.Fatalf("%d: expected offset %d, got %d", v, s, n)
+		}
+		if !bytes.Equal(AppendVarint64(nil, v), b) {
+			t.Fatalf("%d: append: no match", v)
+		}
+		if n, err := SkipVarint64(0, b); err != nil || n != s {
+			t.Fatalf("%d: skip: (%d, %v)", v, n, err)
+		}
+
+		n, retV, err := UnmarshalVarint64(0, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != s || retV != v {
+			t.Fatalf("%d: got (%d, %d)", v, n, retV)
+		}
+	}
+
+	uvalues := []uint64{0, 1, 127, 128, math.MaxUint32, math.MaxUint32 + 1, math.MaxUint64}
+	for _, v := range uvalues {
+		s := SizeUvarint64(v)
+		b := make([]byte, s)
+		if n := MarshalUvarint64(0, b, v); n != s {
+			t.Fatalf("%d: expected offset %d, got %d", v, s, n)
+		}
+		if !bytes.Equal(AppendUvarint64(nil, v), b) {
+			t.Fatalf("%d: append: no match", v)
+		}
+
+		n, retV, err := UnmarshalUvarint64(0, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != s || retV != v {
+			t.Fatalf("%d: got (%d, %d)", v, n, retV)
+		}
+	}
+}
+
+func TestVarint32(t *testing.T) {
+	values := []int32{0, 1, -1, 63, -64, 64, math.MaxInt32, math.MinInt32}
+	for _, v := range values {
+		s := SizeVarint32(v)
+		b := make([]byte, s)
+		if n := MarshalVarint32(0, b, v); n != s {
+			t.Fatalf("%d: expected offset %d, got %d", v, s, n)
+		}
+		if !bytes.Equal(AppendVarint32(nil, v), b) {
+			t.Fatalf("%d: append: no match", v)
+		}
+		if n, err := SkipVarint32(0, b); err != nil || n != s {
+			t.Fatalf("%d: skip: (%d, %v)", v, n, err)
+		}
+
+		n, retV, err := UnmarshalVarint32(0, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != s || retV != v {
+			t.Fatalf("%d: got (%d, %d)", v, n, retV)
+		}
+
+		// A 32-bit varint is read by the 64-bit family.
+		if _, retV64, err := UnmarshalVarint64(0, b); err != nil || retV64 != int64(v) {
+			t.Fatalf("%d: 64-bit: got (%d, %v)", v, retV64, err)
+		}
+	}
+
+	uvalues := []uint32{0, 1, 127, 128, math.MaxUint16, math.MaxUint32}
+	for _, v := range uvalues {
+		s := SizeUvarint32(v)
+		b := make([]byte, s)
+		if n := MarshalUvarint32(0, b, v); n != s {
+			t.Fatalf("%d: expected offset %d, got %d", v, s, n)
+		}
+		if !bytes.Equal(AppendUvarint32(nil, v), b) {
+			t.Fatalf("%d: append: no match", v)
+		}
+
+		n, retV, err := UnmarshalUvarint32(0, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != s || retV != v {
+			t.Fatalf("%d: got (%d, %d)", v, n, retV)
+		}
+	}
+}
+
+func TestVarintOverflow(t *testing.T) {
+	tooBig32 := AppendUvarint64(nil, math.MaxUint32+1)
+	if _, _, err := UnmarshalUvarint32(0, tooBig32); !errors.Is(err, benc.ErrOverflow) {
+		t.Fatalf("expected ErrOverflow, got %v", err)
+	}
+	if _, err := SkipVarint32(0, tooBig32); !errors.Is(err, benc.ErrOverflow) {
+		t.Fatalf("expected ErrOverflow, got %v", err)
+	}
+	if _, _, err := UnmarshalVarint32(0, AppendVarint64(nil, math.MinInt32-1)); !errors.Is(err, benc.ErrOverflow) {
+		t.Fatalf("expected ErrOverflow, got %v", err)
+	}
+
+	tooBig64 := []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x02}
+	if _, _, err := UnmarshalUvarint64(0, tooBig64); !errors.Is(err, benc.ErrOverflow) {
+		t.Fatalf("expected ErrOverflow, got %v", err)
+	}
+	if _, err := SkipVarint64(0, tooBig64); !errors.Is(err, benc.ErrOverflow) {
+		t.Fatalf("expected ErrOverflow, got %v", err)
+	}
+
+	if _, _, err := UnmarshalVarint64(0, []byte{0x80}); !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected ErrBufTooSmall, got %v", err)
+	}
+}
+
+func TestIntUsesVarint64(t *testing.T) {
+	for _, v := range []int{0, -1, math.MaxInt32, math.MinInt32} {
+		if !bytes.Equal(AppendInt(nil, v), AppendVarint64(nil, int64(v))) {
+			t.Fatalf("%d: int: no match", v)
+		}
+		if !bytes.Equal(AppendUint(nil, uint(v)), AppendUvarint64(nil, uint64(uint(v)))) {
+			t.Fatalf("%d: uint: no match", v)
+		}
+	}
+
+	// A value written on a 64-bit architecture fails on a 32-bit one, instead of being truncated.
+	b := AppendVarint64(nil, math.MaxInt64)
+	_, _, err := UnmarshalInt(0, b)
+	if strconv.IntSize == 64 && err != nil {
+		t.Fatal(err)
+	}
+	if strconv.IntSize == 32 && !errors.Is(err, benc.ErrOverflow) {
+		t.Fatalf("expected ErrOverflow, got %v", err)
+	}
+}
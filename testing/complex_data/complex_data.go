@@ -4,6 +4,8 @@
 package complex_data
 
 import (
+	"github.com/deneonet/benc"
+	"github.com/deneonet/benc/frame"
 	"github.com/deneonet/benc/impl/gen"
 	"github.com/deneonet/benc/std"
 )
@@ -22,6 +24,11 @@ type ComplexData struct {
 // Reserved Ids - ComplexData
 var complexDataRIds = []uint16{}
 
+// Interfaces - ComplexData
+var _ benc.Message = (*ComplexData)(nil)
+var _ benc.PlainMessage = (*ComplexData)(nil)
+var _ benc.NestedMessage = (*ComplexData)(nil)
+
 // Size - ComplexData
 func (complexData *ComplexData) Size() int {
 	return complexData.NestedSize(0)
@@ -32,7 +39,7 @@ func (complexData *ComplexData) NestedSize(id uint16) (s int) {
 	s += bstd.SizeInt(complexData.Id) + 2
 	s += bstd.SizeString(complexData.Title) + 2
 	s += bstd.SizeSlice(complexData.Items, func(s SubItem) int { return s.SizePlain() }) + 2
-	s += bstd.SizeMap(complexData.Metadata, bstd.SizeString, bstd.SizeInt32) + 2
+	s += bstd.SizeMapFunc(complexData.Metadata, bstd.SizeString, bstd.FixedSize[int32](bstd.SizeInt32())) + 2
 	s += complexData.Sub_data.NestedSize(5)
 	s += bstd.SizeSlice(complexData.Large_binary_data, bstd.SizeBytes) + 2
 	s += bstd.SizeFixedSlice(complexData.Huge_list, bstd.SizeInt64()) + 2
@@ -50,7 +57,7 @@ func (complexData *ComplexData) SizePlain() (s int) {
 	s += bstd.SizeInt(complexData.Id)
 	s += bstd.SizeString(complexData.Title)
 	s += bstd.SizeSlice(complexData.Items, func(s SubItem) int { return s.SizePlain() })
-	s += bstd.SizeMap(complexData.Metadata, bstd.SizeString, bstd.SizeInt32)
+	s += bstd.SizeMapFunc(complexData.Metadata, bstd.SizeString, bstd.FixedSize[int32](bstd.SizeInt32()))
 	s += complexData.Sub_data.SizePlain()
 	s += bstd.SizeSlice(complexData.Large_binary_data, bstd.SizeBytes)
 	s += bstd.SizeFixedSlice(complexData.Huge_list, bstd.SizeInt64())
@@ -77,7 +84,7 @@ func (complexData *ComplexData) NestedMarshal(tn int, b []byte, id uint16) (n in
 	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 6)
 	n = bstd.MarshalSlice(n, b, complexData.Large_binary_data, bstd.MarshalBytes)
 	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 7)
-	n = bstd.MarshalSlice(n, b, complexData.Huge_list, bstd.MarshalInt64)
+	n = bstd.MarshalInt64Slice(n, b, complexData.Huge_list)
 
 	n += 2
 	b[n-2] = 1
@@ -94,18 +101,66 @@ func (complexData *ComplexData) MarshalPlain(tn int, b []byte) (n int) {
 	n = bstd.MarshalMap(n, b, complexData.Metadata, bstd.MarshalString, bstd.MarshalInt32)
 	n = complexData.Sub_data.MarshalPlain(n, b)
 	n = bstd.MarshalSlice(n, b, complexData.Large_binary_data, bstd.MarshalBytes)
-	n = bstd.MarshalSlice(n, b, complexData.Huge_list, bstd.MarshalInt64)
+	n = bstd.MarshalInt64Slice(n, b, complexData.Huge_list)
 	return n
 }
 
+// MarshalAppend - ComplexData
+func (complexData *ComplexData) MarshalAppend(b []byte) []byte {
+	return complexData.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - ComplexData
+func (complexData *ComplexData) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Varint, 1)
+	b = bstd.AppendInt(b, complexData.Id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 2)
+	b = bstd.AppendString(b, complexData.Title)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 3)
+	b = bstd.AppendSlice(b, complexData.Items, func(b []byte, s SubItem) []byte { return s.MarshalPlainAppend(b) })
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 4)
+	b = bstd.AppendMap(b, complexData.Metadata, bstd.AppendString, bstd.AppendInt32)
+	b = complexData.Sub_data.NestedMarshalAppend(b, 5)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 6)
+	b = bstd.AppendSlice(b, complexData.Large_binary_data, bstd.AppendBytes)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 7)
+	b = bstd.AppendInt64Slice(b, complexData.Huge_list)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - ComplexData
+func (complexData *ComplexData) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendInt(b, complexData.Id)
+	b = bstd.AppendString(b, complexData.Title)
+	b = bstd.AppendSlice(b, complexData.Items, func(b []byte, s SubItem) []byte { return s.MarshalPlainAppend(b) })
+	b = bstd.AppendMap(b, complexData.Metadata, bstd.AppendString, bstd.AppendInt32)
+	b = complexData.Sub_data.MarshalPlainAppend(b)
+	b = bstd.AppendSlice(b, complexData.Large_binary_data, bstd.AppendBytes)
+	b = bstd.AppendInt64Slice(b, complexData.Huge_list)
+	return b
+}
+
 // Unmarshal - ComplexData
 func (complexData *ComplexData) Unmarshal(b []byte) (err error) {
 	_, err = complexData.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - ComplexData
+func (complexData *ComplexData) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = complexData.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - ComplexData
 func (complexData *ComplexData) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return complexData.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - ComplexData
+func (complexData *ComplexData) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -117,100 +172,306 @@ func (complexData *ComplexData) NestedUnmarshal(tn int, b []byte, r []uint16, id
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "id", 1)
 	}
 	if ok {
 		if n, complexData.Id, err = bstd.UnmarshalInt(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "id", 1)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 2); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "title", 2)
 	}
 	if ok {
-		if n, complexData.Title, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, complexData.Title, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "title", 2)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 3); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "items", 3)
 	}
 	if ok {
-		if n, complexData.Items, err = bstd.UnmarshalSlice[SubItem](n, b, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlain(n, b) }); err != nil {
-			return
+		if n, complexData.Items, err = bstd.UnmarshalSliceIntoLimited[SubItem](l, n, b, nil, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "items", 3)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 4); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "metadata", 4)
 	}
 	if ok {
-		if n, complexData.Metadata, err = bstd.UnmarshalMap[string, int32](n, b, bstd.UnmarshalString, bstd.UnmarshalInt32); err != nil {
-			return
+		if n, complexData.Metadata, err = bstd.UnmarshalMapFuncLimited[string, int32](l, n, b, l.UnmarshalString, bstd.UnmarshalInt32); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "metadata", 4)
 		}
 	}
-	if n, err = complexData.Sub_data.NestedUnmarshal(n, b, complexDataRIds, 5); err != nil {
-		return
+	if n, err = complexData.Sub_data.NestedUnmarshalLimited(n, b, complexDataRIds, 5, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "sub_data", 5)
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 6); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "large_binary_data", 6)
 	}
 	if ok {
-		if n, complexData.Large_binary_data, err = bstd.UnmarshalSlice[[]byte](n, b, bstd.UnmarshalBytesCropped); err != nil {
-			return
+		if n, complexData.Large_binary_data, err = bstd.UnmarshalSliceFuncLimited[[]byte](l, n, b, l.UnmarshalBytesCropped); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "large_binary_data", 6)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 7); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "huge_list", 7)
 	}
 	if ok {
-		if n, complexData.Huge_list, err = bstd.UnmarshalSlice[int64](n, b, bstd.UnmarshalInt64); err != nil {
-			return
+		if n, complexData.Huge_list, err = bstd.UnmarshalInt64SliceLimited(l, n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "huge_list", 7)
 		}
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - ComplexData
 func (complexData *ComplexData) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return complexData.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - ComplexData
+func (complexData *ComplexData) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
 	if n, complexData.Id, err = bstd.UnmarshalInt(n, b); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "id", 1)
 	}
-	if n, complexData.Title, err = bstd.UnmarshalString(n, b); err != nil {
-		return
+	if n, complexData.Title, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "title", 2)
 	}
-	if n, complexData.Items, err = bstd.UnmarshalSlice[SubItem](n, b, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlain(n, b) }); err != nil {
-		return
+	if n, complexData.Items, err = bstd.UnmarshalSliceIntoLimited[SubItem](l, n, b, nil, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "items", 3)
 	}
-	if n, complexData.Metadata, err = bstd.UnmarshalMap[string, int32](n, b, bstd.UnmarshalString, bstd.UnmarshalInt32); err != nil {
-		return
+	if n, complexData.Metadata, err = bstd.UnmarshalMapFuncLimited[string, int32](l, n, b, l.UnmarshalString, bstd.UnmarshalInt32); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "metadata", 4)
+	}
+	if n, err = complexData.Sub_data.UnmarshalPlainLimited(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "sub_data", 5)
 	}
-	if n, err = complexData.Sub_data.UnmarshalPlain(n, b); err != nil {
+	if n, complexData.Large_binary_data, err = bstd.UnmarshalSliceFuncLimited[[]byte](l, n, b, l.UnmarshalBytesCropped); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "large_binary_data", 6)
+	}
+	if n, complexData.Huge_list, err = bstd.UnmarshalInt64SliceLimited(l, n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "huge_list", 7)
+	}
+	return
+}
+
+// UnmarshalReuse - ComplexData
+func (complexData *ComplexData) UnmarshalReuse(b []byte) (err error) {
+	_, err = complexData.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - ComplexData
+func (complexData *ComplexData) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	complexData.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
-	if n, complexData.Large_binary_data, err = bstd.UnmarshalSlice[[]byte](n, b, bstd.UnmarshalBytesCropped); err != nil {
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "id", 1)
+	}
+	if ok {
+		if n, complexData.Id, err = bstd.UnmarshalInt(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "id", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "title", 2)
+	}
+	if ok {
+		if n, complexData.Title, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "title", 2)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 3); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "items", 3)
+	}
+	if ok {
+		if n, complexData.Items, err = bstd.UnmarshalSliceIntoLimited[SubItem](l, n, b, complexData.Items, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlainReuse(n, b, l) }); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "items", 3)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 4); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "metadata", 4)
+	}
+	if ok {
+		if n, complexData.Metadata, err = bstd.UnmarshalMapIntoLimited[string, int32](l, n, b, complexData.Metadata, l.UnmarshalString, bstd.UnmarshalInt32); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "metadata", 4)
+		}
+	}
+	if n, err = complexData.Sub_data.NestedUnmarshalReuse(n, b, complexDataRIds, 5, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "sub_data", 5)
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 6); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "large_binary_data", 6)
+	}
+	if ok {
+		if n, complexData.Large_binary_data, err = bstd.UnmarshalSliceIntoLimited[[]byte](l, n, b, complexData.Large_binary_data, bstd.ToUnmarshalIntoFunc(l.UnmarshalBytesCropped)); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "large_binary_data", 6)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, complexDataRIds, 7); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "huge_list", 7)
+	}
+	if ok {
+		if n, complexData.Huge_list, err = bstd.UnmarshalInt64SliceIntoLimited(l, n, b, complexData.Huge_list); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "ComplexData", "huge_list", 7)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - ComplexData
+func (complexData *ComplexData) Reset() {
+	complexData.Id = 0
+	complexData.Title = ""
+	complexData.Items = complexData.Items[:0]
+	clear(complexData.Metadata)
+	complexData.Sub_data.Reset()
+	complexData.Large_binary_data = complexData.Large_binary_data[:0]
+	complexData.Huge_list = complexData.Huge_list[:0]
+}
+
+// UnmarshalPlainReuse - ComplexData
+func (complexData *ComplexData) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, complexData.Id, err = bstd.UnmarshalInt(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "id", 1)
+	}
+	if n, complexData.Title, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "title", 2)
+	}
+	if n, complexData.Items, err = bstd.UnmarshalSliceIntoLimited[SubItem](l, n, b, complexData.Items, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlainReuse(n, b, l) }); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "items", 3)
+	}
+	if n, complexData.Metadata, err = bstd.UnmarshalMapIntoLimited[string, int32](l, n, b, complexData.Metadata, l.UnmarshalString, bstd.UnmarshalInt32); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "metadata", 4)
+	}
+	if n, err = complexData.Sub_data.UnmarshalPlainReuse(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "sub_data", 5)
+	}
+	if n, complexData.Large_binary_data, err = bstd.UnmarshalSliceIntoLimited[[]byte](l, n, b, complexData.Large_binary_data, bstd.ToUnmarshalIntoFunc(l.UnmarshalBytesCropped)); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "large_binary_data", 6)
+	}
+	if n, complexData.Huge_list, err = bstd.UnmarshalInt64SliceIntoLimited(l, n, b, complexData.Huge_list); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "ComplexData", "huge_list", 7)
+	}
+	return
+}
+
+// SizeFramed - ComplexData
+func (complexData *ComplexData) SizeFramed() int {
+	return bframe.Size(complexData.Size())
+}
+
+// MarshalFramed - ComplexData
+func (complexData *ComplexData) MarshalFramed(b []byte) {
+	start := bframe.Reserve(0, b)
+	bframe.Finish(start, complexData.NestedMarshal(start, b, 0), b)
+}
+
+// UnmarshalFramed - ComplexData
+func (complexData *ComplexData) UnmarshalFramed(b []byte) (err error) {
+	var body []byte
+	if _, body, err = bframe.Unmarshal(0, b); err != nil {
 		return
 	}
-	if n, complexData.Huge_list, err = bstd.UnmarshalSlice[int64](n, b, bstd.UnmarshalInt64); err != nil {
+	return complexData.Unmarshal(body)
+}
+
+// MarshalSealed - ComplexData
+func (complexData *ComplexData) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := complexData.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - ComplexData
+func (complexData *ComplexData) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
 		return
 	}
-	return
+	return complexData.Unmarshal(b)
+}
+
+// View - ComplexData
+// Iterates over the slices and maps of a marshalled ComplexData, without unmarshalling it.
+type ComplexDataView []byte
+
+// View Items - ComplexData
+func (v ComplexDataView) Items(l *bstd.DecodeLimits) (*bstd.SliceIter[SubItem], error) {
+	it, err := bgenimpl.IterSliceField[SubItem](v, 3, bstd.ToUnmarshalFunc(func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }))
+	if err != nil {
+		return nil, bgenimpl.WrapFieldError(err, "ComplexData", "items", 3)
+	}
+	return it, nil
+}
+
+// View Metadata - ComplexData
+func (v ComplexDataView) Metadata(l *bstd.DecodeLimits) (*bstd.MapIter[string, int32], error) {
+	it, err := bgenimpl.IterMapField[string, int32](v, 4, l.UnmarshalString, bstd.UnmarshalInt32)
+	if err != nil {
+		return nil, bgenimpl.WrapFieldError(err, "ComplexData", "metadata", 4)
+	}
+	return it, nil
+}
+
+// View Large_binary_data - ComplexData
+func (v ComplexDataView) Large_binary_data(l *bstd.DecodeLimits) (*bstd.SliceIter[[]byte], error) {
+	it, err := bgenimpl.IterSliceField[[]byte](v, 6, l.UnmarshalBytesCropped)
+	if err != nil {
+		return nil, bgenimpl.WrapFieldError(err, "ComplexData", "large_binary_data", 6)
+	}
+	return it, nil
+}
+
+// View Huge_list - ComplexData
+func (v ComplexDataView) Huge_list(l *bstd.DecodeLimits) (*bstd.SliceIter[int64], error) {
+	it, err := bgenimpl.IterSliceField[int64](v, 7, bstd.UnmarshalInt64)
+	if err != nil {
+		return nil, bgenimpl.WrapFieldError(err, "ComplexData", "huge_list", 7)
+	}
+	return it, nil
 }
 
 // Struct - SubItem
@@ -223,6 +484,11 @@ type SubItem struct {
 // Reserved Ids - SubItem
 var subItemRIds = []uint16{}
 
+// Interfaces - SubItem
+var _ benc.Message = (*SubItem)(nil)
+var _ benc.PlainMessage = (*SubItem)(nil)
+var _ benc.NestedMessage = (*SubItem)(nil)
+
 // Size - SubItem
 func (subItem *SubItem) Size() int {
 	return subItem.NestedSize(0)
@@ -280,14 +546,51 @@ func (subItem *SubItem) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - SubItem
+func (subItem *SubItem) MarshalAppend(b []byte) []byte {
+	return subItem.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - SubItem
+func (subItem *SubItem) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed32, 1)
+	b = bstd.AppendInt32(b, subItem.Sub_id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 2)
+	b = bstd.AppendString(b, subItem.Description)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 3)
+	b = bstd.AppendSlice(b, subItem.Sub_items, func(b []byte, s SubSubItem) []byte { return s.MarshalPlainAppend(b) })
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - SubItem
+func (subItem *SubItem) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendInt32(b, subItem.Sub_id)
+	b = bstd.AppendString(b, subItem.Description)
+	b = bstd.AppendSlice(b, subItem.Sub_items, func(b []byte, s SubSubItem) []byte { return s.MarshalPlainAppend(b) })
+	return b
+}
+
 // Unmarshal - SubItem
 func (subItem *SubItem) Unmarshal(b []byte) (err error) {
 	_, err = subItem.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - SubItem
+func (subItem *SubItem) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = subItem.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - SubItem
 func (subItem *SubItem) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return subItem.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - SubItem
+func (subItem *SubItem) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -299,52 +602,179 @@ func (subItem *SubItem) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16)
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_id", 1)
 	}
 	if ok {
 		if n, subItem.Sub_id, err = bstd.UnmarshalInt32(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_id", 1)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subItemRIds, 2); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "description", 2)
 	}
 	if ok {
-		if n, subItem.Description, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, subItem.Description, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubItem", "description", 2)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subItemRIds, 3); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_items", 3)
 	}
 	if ok {
-		if n, subItem.Sub_items, err = bstd.UnmarshalSlice[SubSubItem](n, b, func(n int, b []byte, s *SubSubItem) (int, error) { return s.UnmarshalPlain(n, b) }); err != nil {
-			return
+		if n, subItem.Sub_items, err = bstd.UnmarshalSliceIntoLimited[SubSubItem](l, n, b, nil, func(n int, b []byte, s *SubSubItem) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_items", 3)
 		}
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - SubItem
 func (subItem *SubItem) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return subItem.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - SubItem
+func (subItem *SubItem) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
 	if n, subItem.Sub_id, err = bstd.UnmarshalInt32(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_id", 1)
+	}
+	if n, subItem.Description, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "description", 2)
+	}
+	if n, subItem.Sub_items, err = bstd.UnmarshalSliceIntoLimited[SubSubItem](l, n, b, nil, func(n int, b []byte, s *SubSubItem) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_items", 3)
+	}
+	return
+}
+
+// UnmarshalReuse - SubItem
+func (subItem *SubItem) UnmarshalReuse(b []byte) (err error) {
+	_, err = subItem.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - SubItem
+func (subItem *SubItem) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	subItem.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
-	if n, subItem.Description, err = bstd.UnmarshalString(n, b); err != nil {
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subItemRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_id", 1)
+	}
+	if ok {
+		if n, subItem.Sub_id, err = bstd.UnmarshalInt32(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_id", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subItemRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "description", 2)
+	}
+	if ok {
+		if n, subItem.Description, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubItem", "description", 2)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subItemRIds, 3); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_items", 3)
+	}
+	if ok {
+		if n, subItem.Sub_items, err = bstd.UnmarshalSliceIntoLimited[SubSubItem](l, n, b, subItem.Sub_items, func(n int, b []byte, s *SubSubItem) (int, error) { return s.UnmarshalPlainReuse(n, b, l) }); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_items", 3)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - SubItem
+func (subItem *SubItem) Reset() {
+	subItem.Sub_id = 0
+	subItem.Description = ""
+	subItem.Sub_items = subItem.Sub_items[:0]
+}
+
+// UnmarshalPlainReuse - SubItem
+func (subItem *SubItem) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, subItem.Sub_id, err = bstd.UnmarshalInt32(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_id", 1)
+	}
+	if n, subItem.Description, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "description", 2)
+	}
+	if n, subItem.Sub_items, err = bstd.UnmarshalSliceIntoLimited[SubSubItem](l, n, b, subItem.Sub_items, func(n int, b []byte, s *SubSubItem) (int, error) { return s.UnmarshalPlainReuse(n, b, l) }); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubItem", "sub_items", 3)
+	}
+	return
+}
+
+// SizeFramed - SubItem
+func (subItem *SubItem) SizeFramed() int {
+	return bframe.Size(subItem.Size())
+}
+
+// MarshalFramed - SubItem
+func (subItem *SubItem) MarshalFramed(b []byte) {
+	start := bframe.Reserve(0, b)
+	bframe.Finish(start, subItem.NestedMarshal(start, b, 0), b)
+}
+
+// UnmarshalFramed - SubItem
+func (subItem *SubItem) UnmarshalFramed(b []byte) (err error) {
+	var body []byte
+	if _, body, err = bframe.Unmarshal(0, b); err != nil {
 		return
 	}
-	if n, subItem.Sub_items, err = bstd.UnmarshalSlice[SubSubItem](n, b, func(n int, b []byte, s *SubSubItem) (int, error) { return s.UnmarshalPlain(n, b) }); err != nil {
+	return subItem.Unmarshal(body)
+}
+
+// MarshalSealed - SubItem
+func (subItem *SubItem) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := subItem.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - SubItem
+func (subItem *SubItem) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
 		return
 	}
-	return
+	return subItem.Unmarshal(b)
+}
+
+// View - SubItem
+// Iterates over the slices and maps of a marshalled SubItem, without unmarshalling it.
+type SubItemView []byte
+
+// View Sub_items - SubItem
+func (v SubItemView) Sub_items(l *bstd.DecodeLimits) (*bstd.SliceIter[SubSubItem], error) {
+	it, err := bgenimpl.IterSliceField[SubSubItem](v, 3, bstd.ToUnmarshalFunc(func(n int, b []byte, s *SubSubItem) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }))
+	if err != nil {
+		return nil, bgenimpl.WrapFieldError(err, "SubItem", "sub_items", 3)
+	}
+	return it, nil
 }
 
 // Struct - SubSubItem
@@ -356,6 +786,11 @@ type SubSubItem struct {
 // Reserved Ids - SubSubItem
 var subSubItemRIds = []uint16{}
 
+// Interfaces - SubSubItem
+var _ benc.Message = (*SubSubItem)(nil)
+var _ benc.PlainMessage = (*SubSubItem)(nil)
+var _ benc.NestedMessage = (*SubSubItem)(nil)
+
 // Size - SubSubItem
 func (subSubItem *SubSubItem) Size() int {
 	return subSubItem.NestedSize(0)
@@ -408,14 +843,48 @@ func (subSubItem *SubSubItem) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - SubSubItem
+func (subSubItem *SubSubItem) MarshalAppend(b []byte) []byte {
+	return subSubItem.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - SubSubItem
+func (subSubItem *SubSubItem) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 1)
+	b = bstd.AppendUnsafeString(b, subSubItem.Sub_sub_id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 2)
+	b = bstd.AppendBytes(b, subSubItem.Sub_sub_data)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - SubSubItem
+func (subSubItem *SubSubItem) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendUnsafeString(b, subSubItem.Sub_sub_id)
+	b = bstd.AppendBytes(b, subSubItem.Sub_sub_data)
+	return b
+}
+
 // Unmarshal - SubSubItem
 func (subSubItem *SubSubItem) Unmarshal(b []byte) (err error) {
 	_, err = subSubItem.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - SubSubItem
+func (subSubItem *SubSubItem) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = subSubItem.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - SubSubItem
 func (subSubItem *SubSubItem) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return subSubItem.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - SubSubItem
+func (subSubItem *SubSubItem) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -427,40 +896,139 @@ func (subSubItem *SubSubItem) NestedUnmarshal(tn int, b []byte, r []uint16, id u
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_id", 1)
 	}
 	if ok {
-		if n, subSubItem.Sub_sub_id, err = bstd.UnmarshalUnsafeString(n, b); err != nil {
-			return
+		if n, subSubItem.Sub_sub_id, err = l.UnmarshalUnsafeString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_id", 1)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subSubItemRIds, 2); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_data", 2)
 	}
 	if ok {
-		if n, subSubItem.Sub_sub_data, err = bstd.UnmarshalBytesCopied(n, b); err != nil {
-			return
+		if n, subSubItem.Sub_sub_data, err = l.UnmarshalBytesCopied(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_data", 2)
 		}
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - SubSubItem
 func (subSubItem *SubSubItem) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return subSubItem.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - SubSubItem
+func (subSubItem *SubSubItem) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
-	if n, subSubItem.Sub_sub_id, err = bstd.UnmarshalUnsafeString(n, b); err != nil {
-		return
+	if n, subSubItem.Sub_sub_id, err = l.UnmarshalUnsafeString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_id", 1)
+	}
+	if n, subSubItem.Sub_sub_data, err = l.UnmarshalBytesCopied(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_data", 2)
 	}
-	if n, subSubItem.Sub_sub_data, err = bstd.UnmarshalBytesCopied(n, b); err != nil {
+	return
+}
+
+// UnmarshalReuse - SubSubItem
+func (subSubItem *SubSubItem) UnmarshalReuse(b []byte) (err error) {
+	_, err = subSubItem.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - SubSubItem
+func (subSubItem *SubSubItem) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	subSubItem.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subSubItemRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_id", 1)
+	}
+	if ok {
+		if n, subSubItem.Sub_sub_id, err = l.UnmarshalUnsafeString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_id", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subSubItemRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_data", 2)
+	}
+	if ok {
+		if n, subSubItem.Sub_sub_data, err = l.UnmarshalBytesCopied(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_data", 2)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - SubSubItem
+func (subSubItem *SubSubItem) Reset() {
+	subSubItem.Sub_sub_id = ""
+	subSubItem.Sub_sub_data = nil
+}
+
+// UnmarshalPlainReuse - SubSubItem
+func (subSubItem *SubSubItem) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, subSubItem.Sub_sub_id, err = l.UnmarshalUnsafeString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_id", 1)
+	}
+	if n, subSubItem.Sub_sub_data, err = l.UnmarshalBytesCopied(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubSubItem", "sub_sub_data", 2)
+	}
 	return
 }
 
+// SizeFramed - SubSubItem
+func (subSubItem *SubSubItem) SizeFramed() int {
+	return bframe.Size(subSubItem.Size())
+}
+
+// MarshalFramed - SubSubItem
+func (subSubItem *SubSubItem) MarshalFramed(b []byte) {
+	start := bframe.Reserve(0, b)
+	bframe.Finish(start, subSubItem.NestedMarshal(start, b, 0), b)
+}
+
+// UnmarshalFramed - SubSubItem
+func (subSubItem *SubSubItem) UnmarshalFramed(b []byte) (err error) {
+	var body []byte
+	if _, body, err = bframe.Unmarshal(0, b); err != nil {
+		return
+	}
+	return subSubItem.Unmarshal(body)
+}
+
+// MarshalSealed - SubSubItem
+func (subSubItem *SubSubItem) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := subSubItem.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - SubSubItem
+func (subSubItem *SubSubItem) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return subSubItem.Unmarshal(b)
+}
+
 // Struct - SubComplexData
 type SubComplexData struct {
 	Sub_id          int32
@@ -473,6 +1041,11 @@ type SubComplexData struct {
 // Reserved Ids - SubComplexData
 var subComplexDataRIds = []uint16{}
 
+// Interfaces - SubComplexData
+var _ benc.Message = (*SubComplexData)(nil)
+var _ benc.PlainMessage = (*SubComplexData)(nil)
+var _ benc.NestedMessage = (*SubComplexData)(nil)
+
 // Size - SubComplexData
 func (subComplexData *SubComplexData) Size() int {
 	return subComplexData.NestedSize(0)
@@ -484,7 +1057,7 @@ func (subComplexData *SubComplexData) NestedSize(id uint16) (s int) {
 	s += bstd.SizeString(subComplexData.Sub_title) + 2
 	s += bstd.SizeSlice(subComplexData.Sub_binary_data, bstd.SizeBytes) + 2
 	s += bstd.SizeSlice(subComplexData.Sub_items, func(s SubItem) int { return s.SizePlain() }) + 2
-	s += bstd.SizeMap(subComplexData.Sub_metadata, bstd.SizeString, bstd.SizeString) + 2
+	s += bstd.SizeMapFunc(subComplexData.Sub_metadata, bstd.SizeString, bstd.SizeString) + 2
 
 	if id > 255 {
 		s += 5
@@ -500,7 +1073,7 @@ func (subComplexData *SubComplexData) SizePlain() (s int) {
 	s += bstd.SizeString(subComplexData.Sub_title)
 	s += bstd.SizeSlice(subComplexData.Sub_binary_data, bstd.SizeBytes)
 	s += bstd.SizeSlice(subComplexData.Sub_items, func(s SubItem) int { return s.SizePlain() })
-	s += bstd.SizeMap(subComplexData.Sub_metadata, bstd.SizeString, bstd.SizeString)
+	s += bstd.SizeMapFunc(subComplexData.Sub_metadata, bstd.SizeString, bstd.SizeString)
 	return
 }
 
@@ -540,14 +1113,57 @@ func (subComplexData *SubComplexData) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - SubComplexData
+func (subComplexData *SubComplexData) MarshalAppend(b []byte) []byte {
+	return subComplexData.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - SubComplexData
+func (subComplexData *SubComplexData) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed32, 1)
+	b = bstd.AppendInt32(b, subComplexData.Sub_id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 2)
+	b = bstd.AppendString(b, subComplexData.Sub_title)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 3)
+	b = bstd.AppendSlice(b, subComplexData.Sub_binary_data, bstd.AppendBytes)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 4)
+	b = bstd.AppendSlice(b, subComplexData.Sub_items, func(b []byte, s SubItem) []byte { return s.MarshalPlainAppend(b) })
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 5)
+	b = bstd.AppendMap(b, subComplexData.Sub_metadata, bstd.AppendString, bstd.AppendString)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - SubComplexData
+func (subComplexData *SubComplexData) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendInt32(b, subComplexData.Sub_id)
+	b = bstd.AppendString(b, subComplexData.Sub_title)
+	b = bstd.AppendSlice(b, subComplexData.Sub_binary_data, bstd.AppendBytes)
+	b = bstd.AppendSlice(b, subComplexData.Sub_items, func(b []byte, s SubItem) []byte { return s.MarshalPlainAppend(b) })
+	b = bstd.AppendMap(b, subComplexData.Sub_metadata, bstd.AppendString, bstd.AppendString)
+	return b
+}
+
 // Unmarshal - SubComplexData
 func (subComplexData *SubComplexData) Unmarshal(b []byte) (err error) {
 	_, err = subComplexData.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - SubComplexData
+func (subComplexData *SubComplexData) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = subComplexData.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - SubComplexData
 func (subComplexData *SubComplexData) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return subComplexData.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - SubComplexData
+func (subComplexData *SubComplexData) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -559,78 +1175,253 @@ func (subComplexData *SubComplexData) NestedUnmarshal(tn int, b []byte, r []uint
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_id", 1)
 	}
 	if ok {
 		if n, subComplexData.Sub_id, err = bstd.UnmarshalInt32(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_id", 1)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subComplexDataRIds, 2); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_title", 2)
 	}
 	if ok {
-		if n, subComplexData.Sub_title, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, subComplexData.Sub_title, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_title", 2)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subComplexDataRIds, 3); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_binary_data", 3)
 	}
 	if ok {
-		if n, subComplexData.Sub_binary_data, err = bstd.UnmarshalSlice[[]byte](n, b, bstd.UnmarshalBytesCropped); err != nil {
-			return
+		if n, subComplexData.Sub_binary_data, err = bstd.UnmarshalSliceFuncLimited[[]byte](l, n, b, l.UnmarshalBytesCropped); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_binary_data", 3)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subComplexDataRIds, 4); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_items", 4)
 	}
 	if ok {
-		if n, subComplexData.Sub_items, err = bstd.UnmarshalSlice[SubItem](n, b, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlain(n, b) }); err != nil {
-			return
+		if n, subComplexData.Sub_items, err = bstd.UnmarshalSliceIntoLimited[SubItem](l, n, b, nil, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_items", 4)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subComplexDataRIds, 5); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_metadata", 5)
 	}
 	if ok {
-		if n, subComplexData.Sub_metadata, err = bstd.UnmarshalMap[string, string](n, b, bstd.UnmarshalString, bstd.UnmarshalString); err != nil {
-			return
+		if n, subComplexData.Sub_metadata, err = bstd.UnmarshalMapFuncLimited[string, string](l, n, b, l.UnmarshalString, l.UnmarshalString); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_metadata", 5)
 		}
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - SubComplexData
 func (subComplexData *SubComplexData) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return subComplexData.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - SubComplexData
+func (subComplexData *SubComplexData) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
 	if n, subComplexData.Sub_id, err = bstd.UnmarshalInt32(n, b); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_id", 1)
 	}
-	if n, subComplexData.Sub_title, err = bstd.UnmarshalString(n, b); err != nil {
-		return
+	if n, subComplexData.Sub_title, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_title", 2)
+	}
+	if n, subComplexData.Sub_binary_data, err = bstd.UnmarshalSliceFuncLimited[[]byte](l, n, b, l.UnmarshalBytesCropped); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_binary_data", 3)
+	}
+	if n, subComplexData.Sub_items, err = bstd.UnmarshalSliceIntoLimited[SubItem](l, n, b, nil, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_items", 4)
+	}
+	if n, subComplexData.Sub_metadata, err = bstd.UnmarshalMapFuncLimited[string, string](l, n, b, l.UnmarshalString, l.UnmarshalString); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_metadata", 5)
 	}
-	if n, subComplexData.Sub_binary_data, err = bstd.UnmarshalSlice[[]byte](n, b, bstd.UnmarshalBytesCropped); err != nil {
+	return
+}
+
+// UnmarshalReuse - SubComplexData
+func (subComplexData *SubComplexData) UnmarshalReuse(b []byte) (err error) {
+	_, err = subComplexData.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - SubComplexData
+func (subComplexData *SubComplexData) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	subComplexData.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
-	if n, subComplexData.Sub_items, err = bstd.UnmarshalSlice[SubItem](n, b, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlain(n, b) }); err != nil {
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subComplexDataRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_id", 1)
+	}
+	if ok {
+		if n, subComplexData.Sub_id, err = bstd.UnmarshalInt32(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_id", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subComplexDataRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_title", 2)
+	}
+	if ok {
+		if n, subComplexData.Sub_title, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_title", 2)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subComplexDataRIds, 3); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_binary_data", 3)
+	}
+	if ok {
+		if n, subComplexData.Sub_binary_data, err = bstd.UnmarshalSliceIntoLimited[[]byte](l, n, b, subComplexData.Sub_binary_data, bstd.ToUnmarshalIntoFunc(l.UnmarshalBytesCropped)); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_binary_data", 3)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subComplexDataRIds, 4); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_items", 4)
+	}
+	if ok {
+		if n, subComplexData.Sub_items, err = bstd.UnmarshalSliceIntoLimited[SubItem](l, n, b, subComplexData.Sub_items, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlainReuse(n, b, l) }); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_items", 4)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, subComplexDataRIds, 5); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_metadata", 5)
+	}
+	if ok {
+		if n, subComplexData.Sub_metadata, err = bstd.UnmarshalMapIntoLimited[string, string](l, n, b, subComplexData.Sub_metadata, l.UnmarshalString, l.UnmarshalString); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_metadata", 5)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - SubComplexData
+func (subComplexData *SubComplexData) Reset() {
+	subComplexData.Sub_id = 0
+	subComplexData.Sub_title = ""
+	subComplexData.Sub_binary_data = subComplexData.Sub_binary_data[:0]
+	subComplexData.Sub_items = subComplexData.Sub_items[:0]
+	clear(subComplexData.Sub_metadata)
+}
+
+// UnmarshalPlainReuse - SubComplexData
+func (subComplexData *SubComplexData) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, subComplexData.Sub_id, err = bstd.UnmarshalInt32(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_id", 1)
+	}
+	if n, subComplexData.Sub_title, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_title", 2)
+	}
+	if n, subComplexData.Sub_binary_data, err = bstd.UnmarshalSliceIntoLimited[[]byte](l, n, b, subComplexData.Sub_binary_data, bstd.ToUnmarshalIntoFunc(l.UnmarshalBytesCropped)); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_binary_data", 3)
+	}
+	if n, subComplexData.Sub_items, err = bstd.UnmarshalSliceIntoLimited[SubItem](l, n, b, subComplexData.Sub_items, func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlainReuse(n, b, l) }); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_items", 4)
+	}
+	if n, subComplexData.Sub_metadata, err = bstd.UnmarshalMapIntoLimited[string, string](l, n, b, subComplexData.Sub_metadata, l.UnmarshalString, l.UnmarshalString); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_metadata", 5)
+	}
+	return
+}
+
+// SizeFramed - SubComplexData
+func (subComplexData *SubComplexData) SizeFramed() int {
+	return bframe.Size(subComplexData.Size())
+}
+
+// MarshalFramed - SubComplexData
+func (subComplexData *SubComplexData) MarshalFramed(b []byte) {
+	start := bframe.Reserve(0, b)
+	bframe.Finish(start, subComplexData.NestedMarshal(start, b, 0), b)
+}
+
+// UnmarshalFramed - SubComplexData
+func (subComplexData *SubComplexData) UnmarshalFramed(b []byte) (err error) {
+	var body []byte
+	if _, body, err = bframe.Unmarshal(0, b); err != nil {
 		return
 	}
-	if n, subComplexData.Sub_metadata, err = bstd.UnmarshalMap[string, string](n, b, bstd.UnmarshalString, bstd.UnmarshalString); err != nil {
+	return subComplexData.Unmarshal(body)
+}
+
+// MarshalSealed - SubComplexData
+func (subComplexData *SubComplexData) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := subComplexData.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - SubComplexData
+func (subComplexData *SubComplexData) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
 		return
 	}
-	return
+	return subComplexData.Unmarshal(b)
+}
+
+// View - SubComplexData
+// Iterates over the slices and maps of a marshalled SubComplexData, without unmarshalling it.
+type SubComplexDataView []byte
+
+// View Sub_binary_data - SubComplexData
+func (v SubComplexDataView) Sub_binary_data(l *bstd.DecodeLimits) (*bstd.SliceIter[[]byte], error) {
+	it, err := bgenimpl.IterSliceField[[]byte](v, 3, l.UnmarshalBytesCropped)
+	if err != nil {
+		return nil, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_binary_data", 3)
+	}
+	return it, nil
+}
+
+// View Sub_items - SubComplexData
+func (v SubComplexDataView) Sub_items(l *bstd.DecodeLimits) (*bstd.SliceIter[SubItem], error) {
+	it, err := bgenimpl.IterSliceField[SubItem](v, 4, bstd.ToUnmarshalFunc(func(n int, b []byte, s *SubItem) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }))
+	if err != nil {
+		return nil, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_items", 4)
+	}
+	return it, nil
+}
+
+// View Sub_metadata - SubComplexData
+func (v SubComplexDataView) Sub_metadata(l *bstd.DecodeLimits) (*bstd.MapIter[string, string], error) {
+	it, err := bgenimpl.IterMapField[string, string](v, 5, l.UnmarshalString, l.UnmarshalString)
+	if err != nil {
+		return nil, bgenimpl.WrapFieldError(err, "SubComplexData", "sub_metadata", 5)
+	}
+	return it, nil
 }
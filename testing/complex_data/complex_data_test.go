@@ -1,11 +1,20 @@
-//go:generate bencgen --in ../schemas/complex_data.benc --out ./ --file ... --lang go
+//go:generate bencgen --in ../schemas/complex_data.benc --out ./ --file ... --lang go --views --framed
 
 package complex_data
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
+
+	"github.com/deneonet/benc"
+	bgenimpl "github.com/deneonet/benc/impl/gen"
+	"github.com/deneonet/benc/std"
 )
 
 func TestComplex(t *testing.T) {
@@ -71,6 +80,271 @@ func TestComplex(t *testing.T) {
 	if !reflect.DeepEqual(data, retData) {
 		t.Fatalf("no match\norg: %v\ndec: %v\n", data, retData)
 	}
+
+	// The maps aren't canonical, so they are compared by their entries only
+	for i := range 20 {
+		data.Metadata[fmt.Sprint("key", i)] = int32(i)
+	}
+	if err := benc.VerifyRoundTrip(&data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestComplexMarshalAppend(t *testing.T) {
+	data := ComplexData{
+		Id:    12345,
+		Title: "Example Complex Data",
+		Items: []SubItem{
+			{
+				Sub_id:      1,
+				Description: "SubItem 1",
+				Sub_items: []SubSubItem{
+					{
+						Sub_sub_id:   "subsub1",
+						Sub_sub_data: []byte{0x01, 0x02, 0x03},
+					},
+				},
+			},
+		},
+		Metadata: map[string]int32{
+			"key1": 10,
+		},
+		Sub_data: SubComplexData{
+			Sub_id:    999,
+			Sub_title: "Sub Complex Data",
+			Sub_binary_data: [][]byte{
+				{0x11, 0x22, 0x33},
+				{0x44, 0x55, 0x66},
+			},
+			Sub_items: []SubItem{
+				{
+					Sub_id:      2,
+					Description: "SubItem 2",
+					Sub_items: []SubSubItem{
+						{
+							Sub_sub_id:   "subsub2",
+							Sub_sub_data: []byte{0xAA, 0xBB, 0xCC},
+						},
+					},
+				},
+			},
+			Sub_metadata: map[string]string{
+				"meta1": "value1",
+			},
+		},
+		Large_binary_data: [][]byte{
+			{0xFF, 0xEE, 0xDD},
+		},
+		Huge_list: []int64{1000000, 2000000, 3000000},
+	}
+
+	expected := make([]byte, data.Size())
+	data.Marshal(expected)
+
+	b := data.MarshalAppend(nil)
+	if !bytes.Equal(b, expected) {
+		t.Fatalf("no match\nappend: %v\noffset: %v", b, expected)
+	}
+
+	expected = make([]byte, data.SizePlain())
+	data.MarshalPlain(0, expected)
+
+	b = data.MarshalPlainAppend(make([]byte, 0, 8))
+	if !bytes.Equal(b, expected) {
+		t.Fatalf("plain: no match\nappend: %v\noffset: %v", b, expected)
+	}
+
+	var retData ComplexData
+	if err := retData.Unmarshal(data.MarshalAppend(nil)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(data, retData) {
+		t.Fatalf("no match\norg: %v\ndec: %v\n", data, retData)
+	}
+}
+
+func TestComplexUnmarshalLimited(t *testing.T) {
+	data := ComplexData{
+		Id:        12345,
+		Title:     "Example Complex Data",
+		Metadata:  map[string]int32{"key1": 10},
+		Huge_list: []int64{1000000, 2000000, 3000000},
+	}
+
+	b := make([]byte, data.Size())
+	data.Marshal(b)
+
+	var retData ComplexData
+	if err := retData.UnmarshalLimited(b, bstd.DecodeLimits{MaxSliceLen: 3, MaxBytesLen: 20, MaxAlloc: 1024}); err != nil {
+		t.Fatal(err)
+	}
+	if retData.Title != data.Title || !reflect.DeepEqual(retData.Huge_list, data.Huge_list) {
+		t.Fatalf("no match\norg: %v\ndec: %v\n", data, retData)
+	}
+
+	for _, l := range []bstd.DecodeLimits{
+		{MaxSliceLen: 2},
+		{MaxBytesLen: 19},
+		{MaxAlloc: 16},
+	} {
+		if err := retData.UnmarshalLimited(b, l); !errors.Is(err, benc.ErrLimitExceeded) {
+			t.Fatalf("%+v: expected benc.ErrLimitExceeded, got %v", l, err)
+		}
+	}
+}
+
+func TestComplexUnmarshalReuse(t *testing.T) {
+	first := ComplexData{
+		Items:     []SubItem{{Sub_id: 1}, {Sub_id: 2}, {Sub_id: 3}},
+		Metadata:  map[string]int32{"key1": 10, "key2": 20},
+		Huge_list: []int64{1000000, 2000000, 3000000},
+	}
+	second := ComplexData{
+		Id:        12345,
+		Items:     []SubItem{{Sub_id: 4, Description: "SubItem 4"}},
+		Metadata:  map[string]int32{"key3": 30},
+		Huge_list: []int64{4000000},
+	}
+
+	var retData ComplexData
+	for _, data := range []ComplexData{first, second} {
+		b := make([]byte, data.Size())
+		data.Marshal(b)
+
+		var want ComplexData
+		if err := want.Unmarshal(b); err != nil {
+			t.Fatal(err)
+		}
+
+		items, list, metadata := retData.Items, retData.Huge_list, retData.Metadata
+		if err := retData.UnmarshalReuse(b); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(want, retData) {
+			t.Fatalf("no match\norg: %v\ndec: %v\n", want, retData)
+		}
+		if items == nil {
+			continue
+		}
+
+		// The second message is smaller, so everything is reused.
+		if &items[0] != &retData.Items[0] || &list[0] != &retData.Huge_list[0] ||
+			reflect.ValueOf(metadata).UnsafePointer() != reflect.ValueOf(retData.Metadata).UnsafePointer() {
+			t.Fatal("expected the slices and the map to be reused")
+		}
+	}
+}
+
+func TestComplexUnmarshalReuseMissingFields(t *testing.T) {
+	data := ComplexData{
+		Id:        12345,
+		Title:     "Example Complex Data",
+		Items:     []SubItem{{Sub_id: 1, Description: "SubItem 1"}},
+		Metadata:  map[string]int32{"key1": 10},
+		Sub_data:  SubComplexData{Sub_id: 99, Sub_title: "Sub Complex Data"},
+		Huge_list: []int64{1000000},
+	}
+
+	b := make([]byte, data.Size())
+	data.Marshal(b)
+
+	var retData ComplexData
+	if err := retData.UnmarshalReuse(b); err != nil {
+		t.Fatal(err)
+	}
+	items, metadata := retData.Items, retData.Metadata
+
+	// Only the id, as marshalled by an older schema, the other fields have to be reset.
+	onlyId := bgenimpl.AppendTag(nil, bgenimpl.Container, 0)
+	onlyId = bgenimpl.AppendTag(onlyId, bgenimpl.Varint, 1)
+	onlyId = bstd.AppendInt(onlyId, 7)
+
+	for _, b := range [][]byte{onlyId, append(onlyId, 1, 1)} {
+		if err := retData.UnmarshalReuse(b); err != nil {
+			t.Fatal(err)
+		}
+		if retData.Id != 7 || retData.Title != "" || len(retData.Items) != 0 || len(retData.Metadata) != 0 ||
+			retData.Sub_data.Sub_id != 0 || retData.Sub_data.Sub_title != "" || len(retData.Huge_list) != 0 {
+			t.Fatalf("expected the missing fields to be reset, got %+v", retData)
+		}
+		if cap(retData.Items) != cap(items) ||
+			reflect.ValueOf(metadata).UnsafePointer() != reflect.ValueOf(retData.Metadata).UnsafePointer() {
+			t.Fatal("expected the slices and the map to keep their memory")
+		}
+	}
+}
+
+func TestComplexView(t *testing.T) {
+	data := ComplexData{
+		Id:        12345,
+		Items:     []SubItem{{Sub_id: 1, Description: "SubItem 1"}, {Sub_id: 2}},
+		Metadata:  map[string]int32{"key1": 10, "key2": 20},
+		Huge_list: []int64{1000000, 2000000, 3000000},
+	}
+
+	b := make([]byte, data.Size())
+	data.Marshal(b)
+	v := ComplexDataView(b)
+
+	list, err := v.Huge_list(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retList := slices.Collect(list.Values()); !reflect.DeepEqual(retList, data.Huge_list) {
+		t.Fatalf("no match\norg: %v\ndec: %v\n", data.Huge_list, retList)
+	}
+
+	items, err := v.Items(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, item := range items.All() {
+		if item.Sub_id != data.Items[i].Sub_id || item.Description != data.Items[i].Description {
+			t.Fatalf("item %d: no match: %v", i, item)
+		}
+	}
+
+	metadata, err := v.Metadata(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retMetadata := maps.Collect(metadata.All()); !reflect.DeepEqual(retMetadata, data.Metadata) || metadata.Err() != nil {
+		t.Fatalf("no match\norg: %v\ndec: %v\n", data.Metadata, retMetadata)
+	}
+
+	// The error contains the path of the viewed field
+	i := bytes.Index(b, []byte("key"))
+	_, err = ComplexDataView(b[:i]).Huge_list(nil)
+	if !errors.Is(err, benc.ErrBufTooSmall) || !strings.Contains(err.Error(), "ComplexData.huge_list(7)") {
+		t.Fatalf("expected benc.ErrBufTooSmall at ComplexData.huge_list(7), got %v", err)
+	}
+}
+
+func TestComplexDecodeError(t *testing.T) {
+	data := ComplexData{
+		Sub_data: SubComplexData{Sub_title: "Example Sub Title"},
+	}
+
+	b := make([]byte, data.Size())
+	data.Marshal(b)
+
+	i := bytes.Index(b, []byte(data.Sub_data.Sub_title))
+	var retData ComplexData
+	err := retData.Unmarshal(b[:i+5])
+	if !errors.Is(err, benc.ErrBufTooSmall) {
+		t.Fatalf("expected benc.ErrBufTooSmall, got %v", err)
+	}
+
+	var de *benc.DecodeError
+	if !errors.As(err, &de) {
+		t.Fatalf("expected a *benc.DecodeError, got %T", err)
+	}
+	if de.Offset != i || de.Type != "string" {
+		t.Fatalf("expected string at offset %d, got %s at offset %d", i, de.Type, de.Offset)
+	}
+	if !reflect.DeepEqual(de.Path, []string{"ComplexData.sub_data(5)", "SubComplexData.sub_title(2)"}) {
+		t.Fatalf("unexpected path: %v", de.Path)
+	}
 }
 
 func BenchmarkComplex(b *testing.B) {
@@ -136,3 +410,39 @@ func BenchmarkComplex(b *testing.B) {
 		}
 	}
 }
+
+func FuzzUnmarshal(f *testing.F) {
+	data := ComplexData{
+		Id:       1,
+		Title:    "Fuzz",
+		Items:    []SubItem{{Sub_id: 2, Sub_items: []SubSubItem{{Sub_sub_id: "a", Sub_sub_data: []byte{1}}}}},
+		Metadata: map[string]int32{"key": 3},
+		Sub_data: SubComplexData{
+			Sub_binary_data: [][]byte{{4}},
+			Sub_metadata:    map[string]string{"meta": "value"},
+		},
+		Huge_list: []int64{5, 6},
+	}
+	f.Add(data.MarshalAppend(nil))
+	f.Add(data.MarshalPlainAppend(nil))
+
+	l := bstd.DecodeLimits{MaxSliceLen: 1 << 16, MaxMapLen: 1 << 16, MaxBytesLen: 1 << 20, MaxAlloc: 1 << 24}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var retData ComplexData
+		_ = retData.Unmarshal(b)
+		_ = retData.UnmarshalLimited(b, l)
+		_ = retData.UnmarshalReuse(b)
+		_, _ = retData.UnmarshalPlain(0, b)
+		_ = retData.UnmarshalFramed(b)
+
+		v := ComplexDataView(b)
+		if it, err := v.Items(&l); err == nil && it != nil {
+			for range it.All() {
+			}
+		}
+		if it, err := v.Metadata(&l); err == nil && it != nil {
+			for range it.All() {
+			}
+		}
+	})
+}
@@ -4,6 +4,9 @@
 package others
 
 import (
+	"time"
+
+	"github.com/deneonet/benc"
 	"github.com/deneonet/benc/impl/gen"
 	"github.com/deneonet/benc/std"
 
@@ -36,6 +39,11 @@ type Bank struct {
 // Reserved Ids - Bank
 var bankRIds = []uint16{}
 
+// Interfaces - Bank
+var _ benc.Message = (*Bank)(nil)
+var _ benc.PlainMessage = (*Bank)(nil)
+var _ benc.NestedMessage = (*Bank)(nil)
+
 // Size - Bank
 func (bank *Bank) Size() int {
 	return bank.NestedSize(0)
@@ -83,14 +91,45 @@ func (bank *Bank) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - Bank
+func (bank *Bank) MarshalAppend(b []byte) []byte {
+	return bank.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - Bank
+func (bank *Bank) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 1)
+	b = bstd.AppendString(b, bank.Name)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - Bank
+func (bank *Bank) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendString(b, bank.Name)
+	return b
+}
+
 // Unmarshal - Bank
 func (bank *Bank) Unmarshal(b []byte) (err error) {
 	_, err = bank.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - Bank
+func (bank *Bank) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = bank.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - Bank
 func (bank *Bank) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return bank.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - Bank
+func (bank *Bank) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -102,26 +141,90 @@ func (bank *Bank) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n in
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Bank", "name", 1)
 	}
 	if ok {
-		if n, bank.Name, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, bank.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Bank", "name", 1)
 		}
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - Bank
 func (bank *Bank) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return bank.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - Bank
+func (bank *Bank) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
-	if n, bank.Name, err = bstd.UnmarshalString(n, b); err != nil {
+	if n, bank.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Bank", "name", 1)
+	}
+	return
+}
+
+// UnmarshalReuse - Bank
+func (bank *Bank) UnmarshalReuse(b []byte) (err error) {
+	_, err = bank.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - Bank
+func (bank *Bank) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	bank.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, bankRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Bank", "name", 1)
+	}
+	if ok {
+		if n, bank.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Bank", "name", 1)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - Bank
+func (bank *Bank) Reset() {
+	bank.Name = ""
+}
+
+// UnmarshalPlainReuse - Bank
+func (bank *Bank) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, bank.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Bank", "name", 1)
+	}
 	return
 }
 
+// MarshalSealed - Bank
+func (bank *Bank) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := bank.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - Bank
+func (bank *Bank) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return bank.Unmarshal(b)
+}
+
 // Struct - Citizen
 type Citizen struct {
 	Name string
@@ -130,6 +233,11 @@ type Citizen struct {
 // Reserved Ids - Citizen
 var citizenRIds = []uint16{}
 
+// Interfaces - Citizen
+var _ benc.Message = (*Citizen)(nil)
+var _ benc.PlainMessage = (*Citizen)(nil)
+var _ benc.NestedMessage = (*Citizen)(nil)
+
 // Size - Citizen
 func (citizen *Citizen) Size() int {
 	return citizen.NestedSize(0)
@@ -177,14 +285,45 @@ func (citizen *Citizen) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - Citizen
+func (citizen *Citizen) MarshalAppend(b []byte) []byte {
+	return citizen.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - Citizen
+func (citizen *Citizen) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 2)
+	b = bstd.AppendString(b, citizen.Name)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - Citizen
+func (citizen *Citizen) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendString(b, citizen.Name)
+	return b
+}
+
 // Unmarshal - Citizen
 func (citizen *Citizen) Unmarshal(b []byte) (err error) {
 	_, err = citizen.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - Citizen
+func (citizen *Citizen) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = citizen.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - Citizen
 func (citizen *Citizen) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return citizen.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - Citizen
+func (citizen *Citizen) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -196,26 +335,90 @@ func (citizen *Citizen) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16)
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Citizen", "name", 2)
 	}
 	if ok {
-		if n, citizen.Name, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, citizen.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Citizen", "name", 2)
 		}
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - Citizen
 func (citizen *Citizen) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return citizen.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - Citizen
+func (citizen *Citizen) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
-	if n, citizen.Name, err = bstd.UnmarshalString(n, b); err != nil {
+	if n, citizen.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Citizen", "name", 2)
+	}
+	return
+}
+
+// UnmarshalReuse - Citizen
+func (citizen *Citizen) UnmarshalReuse(b []byte) (err error) {
+	_, err = citizen.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - Citizen
+func (citizen *Citizen) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	citizen.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, citizenRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Citizen", "name", 2)
+	}
+	if ok {
+		if n, citizen.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Citizen", "name", 2)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - Citizen
+func (citizen *Citizen) Reset() {
+	citizen.Name = ""
+}
+
+// UnmarshalPlainReuse - Citizen
+func (citizen *Citizen) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, citizen.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Citizen", "name", 2)
+	}
 	return
 }
 
+// MarshalSealed - Citizen
+func (citizen *Citizen) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := citizen.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - Citizen
+func (citizen *Citizen) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return citizen.Unmarshal(b)
+}
+
 // Struct - OthersTest
 type OthersTest struct {
 	Ui           uint
@@ -234,6 +437,11 @@ type OthersTest struct {
 // Reserved Ids - OthersTest
 var othersTestRIds = []uint16{}
 
+// Interfaces - OthersTest
+var _ benc.Message = (*OthersTest)(nil)
+var _ benc.PlainMessage = (*OthersTest)(nil)
+var _ benc.NestedMessage = (*OthersTest)(nil)
+
 // Size - OthersTest
 func (othersTest *OthersTest) Size() int {
 	return othersTest.NestedSize(0)
@@ -244,7 +452,7 @@ func (othersTest *OthersTest) NestedSize(id uint16) (s int) {
 	s += bstd.SizeUint(othersTest.Ui) + 2
 	s += bstd.SizeUint64() + 2
 	s += bstd.SizeFixedSlice(othersTest.Ui64Arr, bstd.SizeUint64()) + 2
-	s += bstd.SizeMap(othersTest.Ui64Map, bstd.SizeUint64, bstd.SizeUint32) + 2
+	s += bstd.SizeMapFunc(othersTest.Ui64Map, bstd.FixedSize[uint64](bstd.SizeUint64()), bstd.FixedSize[uint32](bstd.SizeUint32())) + 2
 	s += bstd.SizeUint32() + 2
 	s += bstd.SizeUint16() + 2
 	s += bgenimpl.SizeEnum(othersTest.ExampleEnum) + 2
@@ -255,7 +463,7 @@ func (othersTest *OthersTest) NestedSize(id uint16) (s int) {
 			return bstd.SizeSlice(s, func(s person.Person2) int { return s.SizePlain() })
 		})
 	}) + 2
-	s += bstd.SizeMap(othersTest.BankMap, func(s Bank) int { return s.SizePlain() }, func(s Citizen) int { return s.SizePlain() }) + 2
+	s += bstd.SizeMapFunc(othersTest.BankMap, func(s Bank) int { return s.SizePlain() }, func(s Citizen) int { return s.SizePlain() }) + 2
 
 	if id > 255 {
 		s += 5
@@ -270,7 +478,7 @@ func (othersTest *OthersTest) SizePlain() (s int) {
 	s += bstd.SizeUint(othersTest.Ui)
 	s += bstd.SizeUint64()
 	s += bstd.SizeFixedSlice(othersTest.Ui64Arr, bstd.SizeUint64())
-	s += bstd.SizeMap(othersTest.Ui64Map, bstd.SizeUint64, bstd.SizeUint32)
+	s += bstd.SizeMapFunc(othersTest.Ui64Map, bstd.FixedSize[uint64](bstd.SizeUint64()), bstd.FixedSize[uint32](bstd.SizeUint32()))
 	s += bstd.SizeUint32()
 	s += bstd.SizeUint16()
 	s += bgenimpl.SizeEnum(othersTest.ExampleEnum)
@@ -281,7 +489,7 @@ func (othersTest *OthersTest) SizePlain() (s int) {
 			return bstd.SizeSlice(s, func(s person.Person2) int { return s.SizePlain() })
 		})
 	})
-	s += bstd.SizeMap(othersTest.BankMap, func(s Bank) int { return s.SizePlain() }, func(s Citizen) int { return s.SizePlain() })
+	s += bstd.SizeMapFunc(othersTest.BankMap, func(s Bank) int { return s.SizePlain() }, func(s Citizen) int { return s.SizePlain() })
 	return
 }
 
@@ -298,9 +506,9 @@ func (othersTest *OthersTest) NestedMarshal(tn int, b []byte, id uint16) (n int)
 	n = bgenimpl.MarshalTag(n, b, bgenimpl.Fixed64, 2)
 	n = bstd.MarshalUint64(n, b, othersTest.Ui64)
 	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 3)
-	n = bstd.MarshalSlice(n, b, othersTest.Ui64Arr, bstd.MarshalUint64)
+	n = bstd.MarshalUint64Slice(n, b, othersTest.Ui64Arr)
 	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 4)
-	n = bstd.MarshalMap(n, b, othersTest.Ui64Map, bstd.MarshalUint64, bstd.MarshalUint32)
+	n = bstd.MarshalSortedMap(n, b, othersTest.Ui64Map, bstd.MarshalUint64, bstd.MarshalUint32)
 	n = bgenimpl.MarshalTag(n, b, bgenimpl.Fixed32, 5)
 	n = bstd.MarshalUint32(n, b, othersTest.Ui32)
 	n = bgenimpl.MarshalTag(n, b, bgenimpl.Fixed16, 6)
@@ -317,7 +525,7 @@ func (othersTest *OthersTest) NestedMarshal(tn int, b []byte, id uint16) (n int)
 		})
 	})
 	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 11)
-	n = bstd.MarshalMap(n, b, othersTest.BankMap, func(n int, b []byte, s Bank) int { return s.MarshalPlain(n, b) }, func(n int, b []byte, s Citizen) int { return s.MarshalPlain(n, b) })
+	n = bstd.MarshalCanonicalMap(n, b, othersTest.BankMap, func(n int, b []byte, s Bank) int { return s.MarshalPlain(n, b) }, func(n int, b []byte, s Citizen) int { return s.MarshalPlain(n, b) })
 
 	n += 2
 	b[n-2] = 1
@@ -330,8 +538,8 @@ func (othersTest *OthersTest) MarshalPlain(tn int, b []byte) (n int) {
 	n = tn
 	n = bstd.MarshalUint(n, b, othersTest.Ui)
 	n = bstd.MarshalUint64(n, b, othersTest.Ui64)
-	n = bstd.MarshalSlice(n, b, othersTest.Ui64Arr, bstd.MarshalUint64)
-	n = bstd.MarshalMap(n, b, othersTest.Ui64Map, bstd.MarshalUint64, bstd.MarshalUint32)
+	n = bstd.MarshalUint64Slice(n, b, othersTest.Ui64Arr)
+	n = bstd.MarshalSortedMap(n, b, othersTest.Ui64Map, bstd.MarshalUint64, bstd.MarshalUint32)
 	n = bstd.MarshalUint32(n, b, othersTest.Ui32)
 	n = bstd.MarshalUint16(n, b, othersTest.Ui16)
 	n = bgenimpl.MarshalEnum(n, b, othersTest.ExampleEnum)
@@ -342,18 +550,86 @@ func (othersTest *OthersTest) MarshalPlain(tn int, b []byte) (n int) {
 			return bstd.MarshalSlice(n, b, s, func(n int, b []byte, s person.Person2) int { return s.MarshalPlain(n, b) })
 		})
 	})
-	n = bstd.MarshalMap(n, b, othersTest.BankMap, func(n int, b []byte, s Bank) int { return s.MarshalPlain(n, b) }, func(n int, b []byte, s Citizen) int { return s.MarshalPlain(n, b) })
+	n = bstd.MarshalCanonicalMap(n, b, othersTest.BankMap, func(n int, b []byte, s Bank) int { return s.MarshalPlain(n, b) }, func(n int, b []byte, s Citizen) int { return s.MarshalPlain(n, b) })
 	return n
 }
 
+// MarshalAppend - OthersTest
+func (othersTest *OthersTest) MarshalAppend(b []byte) []byte {
+	return othersTest.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - OthersTest
+func (othersTest *OthersTest) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Varint, 1)
+	b = bstd.AppendUint(b, othersTest.Ui)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed64, 2)
+	b = bstd.AppendUint64(b, othersTest.Ui64)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 3)
+	b = bstd.AppendUint64Slice(b, othersTest.Ui64Arr)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 4)
+	b = bstd.AppendSortedMap(b, othersTest.Ui64Map, bstd.AppendUint64, bstd.AppendUint32)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed32, 5)
+	b = bstd.AppendUint32(b, othersTest.Ui32)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed16, 6)
+	b = bstd.AppendUint16(b, othersTest.Ui16)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 7)
+	b = bgenimpl.AppendEnum(b, othersTest.ExampleEnum)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 8)
+	b = bgenimpl.AppendEnum(b, othersTest.ExampleEnum2)
+	b = othersTest.Person.NestedMarshalAppend(b, 9)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 10)
+	b = bstd.AppendSlice(b, othersTest.Person2, func(b []byte, s [][]person.Person2) []byte {
+		return bstd.AppendSlice(b, s, func(b []byte, s []person.Person2) []byte {
+			return bstd.AppendSlice(b, s, func(b []byte, s person.Person2) []byte { return s.MarshalPlainAppend(b) })
+		})
+	})
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 11)
+	b = bstd.AppendCanonicalMap(b, othersTest.BankMap, func(b []byte, s Bank) []byte { return s.MarshalPlainAppend(b) }, func(b []byte, s Citizen) []byte { return s.MarshalPlainAppend(b) })
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - OthersTest
+func (othersTest *OthersTest) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendUint(b, othersTest.Ui)
+	b = bstd.AppendUint64(b, othersTest.Ui64)
+	b = bstd.AppendUint64Slice(b, othersTest.Ui64Arr)
+	b = bstd.AppendSortedMap(b, othersTest.Ui64Map, bstd.AppendUint64, bstd.AppendUint32)
+	b = bstd.AppendUint32(b, othersTest.Ui32)
+	b = bstd.AppendUint16(b, othersTest.Ui16)
+	b = bgenimpl.AppendEnum(b, othersTest.ExampleEnum)
+	b = bgenimpl.AppendEnum(b, othersTest.ExampleEnum2)
+	b = othersTest.Person.MarshalPlainAppend(b)
+	b = bstd.AppendSlice(b, othersTest.Person2, func(b []byte, s [][]person.Person2) []byte {
+		return bstd.AppendSlice(b, s, func(b []byte, s []person.Person2) []byte {
+			return bstd.AppendSlice(b, s, func(b []byte, s person.Person2) []byte { return s.MarshalPlainAppend(b) })
+		})
+	})
+	b = bstd.AppendCanonicalMap(b, othersTest.BankMap, func(b []byte, s Bank) []byte { return s.MarshalPlainAppend(b) }, func(b []byte, s Citizen) []byte { return s.MarshalPlainAppend(b) })
+	return b
+}
+
 // Unmarshal - OthersTest
 func (othersTest *OthersTest) Unmarshal(b []byte) (err error) {
 	_, err = othersTest.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - OthersTest
+func (othersTest *OthersTest) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = othersTest.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - OthersTest
 func (othersTest *OthersTest) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return othersTest.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - OthersTest
+func (othersTest *OthersTest) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -365,162 +641,1466 @@ func (othersTest *OthersTest) NestedUnmarshal(tn int, b []byte, r []uint16, id u
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui", 1)
 	}
 	if ok {
 		if n, othersTest.Ui, err = bstd.UnmarshalUint(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui", 1)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 2); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64", 2)
 	}
 	if ok {
 		if n, othersTest.Ui64, err = bstd.UnmarshalUint64(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64", 2)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 3); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Arr", 3)
 	}
 	if ok {
-		if n, othersTest.Ui64Arr, err = bstd.UnmarshalSlice[uint64](n, b, bstd.UnmarshalUint64); err != nil {
-			return
+		if n, othersTest.Ui64Arr, err = bstd.UnmarshalUint64SliceLimited(l, n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Arr", 3)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 4); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Map", 4)
 	}
 	if ok {
-		if n, othersTest.Ui64Map, err = bstd.UnmarshalMap[uint64, uint32](n, b, bstd.UnmarshalUint64, bstd.UnmarshalUint32); err != nil {
-			return
+		if n, othersTest.Ui64Map, err = bstd.UnmarshalMapFuncLimited[uint64, uint32](l, n, b, bstd.UnmarshalUint64, bstd.UnmarshalUint32); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Map", 4)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 5); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui32", 5)
 	}
 	if ok {
 		if n, othersTest.Ui32, err = bstd.UnmarshalUint32(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui32", 5)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 6); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui16", 6)
 	}
 	if ok {
 		if n, othersTest.Ui16, err = bstd.UnmarshalUint16(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui16", 6)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 7); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum", 7)
 	}
 	if ok {
 		if n, othersTest.ExampleEnum, err = bgenimpl.UnmarshalEnum[ExampleEnum](n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum", 7)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 8); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum2", 8)
 	}
 	if ok {
 		if n, othersTest.ExampleEnum2, err = bgenimpl.UnmarshalEnum[ExampleEnum2](n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum2", 8)
 		}
 	}
-	if n, err = othersTest.Person.NestedUnmarshal(n, b, othersTestRIds, 9); err != nil {
-		return
+	if n, err = othersTest.Person.NestedUnmarshalLimited(n, b, othersTestRIds, 9, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "person", 9)
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 10); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "person2", 10)
 	}
 	if ok {
-		if n, othersTest.Person2, err = bstd.UnmarshalSlice[[][]person.Person2](n, b, func(n int, b []byte) (int, [][]person.Person2, error) {
-			return bstd.UnmarshalSlice[[]person.Person2](n, b, func(n int, b []byte) (int, []person.Person2, error) {
-				return bstd.UnmarshalSlice[person.Person2](n, b, func(n int, b []byte, s *person.Person2) (int, error) { return s.UnmarshalPlain(n, b) })
+		if n, othersTest.Person2, err = bstd.UnmarshalSliceFuncLimited[[][]person.Person2](l, n, b, func(n int, b []byte) (int, [][]person.Person2, error) {
+			return bstd.UnmarshalSliceFuncLimited[[]person.Person2](l, n, b, func(n int, b []byte) (int, []person.Person2, error) {
+				return bstd.UnmarshalSliceIntoLimited[person.Person2](l, n, b, nil, func(n int, b []byte, s *person.Person2) (int, error) { return s.UnmarshalPlainLimited(n, b, l) })
 			})
 		}); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "person2", 10)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 11); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "bankMap", 11)
 	}
 	if ok {
-		if n, othersTest.BankMap, err = bstd.UnmarshalMap[Bank, Citizen](n, b, func(n int, b []byte, s *Bank) (int, error) { return s.UnmarshalPlain(n, b) }, func(n int, b []byte, s *Citizen) (int, error) { return s.UnmarshalPlain(n, b) }); err != nil {
-			return
+		if n, othersTest.BankMap, err = bstd.UnmarshalMapFuncLimited[Bank, Citizen](l, n, b, bstd.ToUnmarshalFunc(func(n int, b []byte, s *Bank) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }), bstd.ToUnmarshalFunc(func(n int, b []byte, s *Citizen) (int, error) { return s.UnmarshalPlainLimited(n, b, l) })); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "bankMap", 11)
 		}
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - OthersTest
 func (othersTest *OthersTest) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return othersTest.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - OthersTest
+func (othersTest *OthersTest) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
 	if n, othersTest.Ui, err = bstd.UnmarshalUint(n, b); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui", 1)
 	}
 	if n, othersTest.Ui64, err = bstd.UnmarshalUint64(n, b); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64", 2)
 	}
-	if n, othersTest.Ui64Arr, err = bstd.UnmarshalSlice[uint64](n, b, bstd.UnmarshalUint64); err != nil {
-		return
+	if n, othersTest.Ui64Arr, err = bstd.UnmarshalUint64SliceLimited(l, n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Arr", 3)
 	}
-	if n, othersTest.Ui64Map, err = bstd.UnmarshalMap[uint64, uint32](n, b, bstd.UnmarshalUint64, bstd.UnmarshalUint32); err != nil {
-		return
+	if n, othersTest.Ui64Map, err = bstd.UnmarshalMapFuncLimited[uint64, uint32](l, n, b, bstd.UnmarshalUint64, bstd.UnmarshalUint32); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Map", 4)
 	}
 	if n, othersTest.Ui32, err = bstd.UnmarshalUint32(n, b); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui32", 5)
 	}
 	if n, othersTest.Ui16, err = bstd.UnmarshalUint16(n, b); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui16", 6)
 	}
 	if n, othersTest.ExampleEnum, err = bgenimpl.UnmarshalEnum[ExampleEnum](n, b); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum", 7)
 	}
 	if n, othersTest.ExampleEnum2, err = bgenimpl.UnmarshalEnum[ExampleEnum2](n, b); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum2", 8)
 	}
-	if n, err = othersTest.Person.UnmarshalPlain(n, b); err != nil {
-		return
+	if n, err = othersTest.Person.UnmarshalPlainLimited(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "person", 9)
 	}
-	if n, othersTest.Person2, err = bstd.UnmarshalSlice[[][]person.Person2](n, b, func(n int, b []byte) (int, [][]person.Person2, error) {
-		return bstd.UnmarshalSlice[[]person.Person2](n, b, func(n int, b []byte) (int, []person.Person2, error) {
-			return bstd.UnmarshalSlice[person.Person2](n, b, func(n int, b []byte, s *person.Person2) (int, error) { return s.UnmarshalPlain(n, b) })
+	if n, othersTest.Person2, err = bstd.UnmarshalSliceFuncLimited[[][]person.Person2](l, n, b, func(n int, b []byte) (int, [][]person.Person2, error) {
+		return bstd.UnmarshalSliceFuncLimited[[]person.Person2](l, n, b, func(n int, b []byte) (int, []person.Person2, error) {
+			return bstd.UnmarshalSliceIntoLimited[person.Person2](l, n, b, nil, func(n int, b []byte, s *person.Person2) (int, error) { return s.UnmarshalPlainLimited(n, b, l) })
 		})
 	}); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "person2", 10)
 	}
-	if n, othersTest.BankMap, err = bstd.UnmarshalMap[Bank, Citizen](n, b, func(n int, b []byte, s *Bank) (int, error) { return s.UnmarshalPlain(n, b) }, func(n int, b []byte, s *Citizen) (int, error) { return s.UnmarshalPlain(n, b) }); err != nil {
-		return
+	if n, othersTest.BankMap, err = bstd.UnmarshalMapFuncLimited[Bank, Citizen](l, n, b, bstd.ToUnmarshalFunc(func(n int, b []byte, s *Bank) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }), bstd.ToUnmarshalFunc(func(n int, b []byte, s *Citizen) (int, error) { return s.UnmarshalPlainLimited(n, b, l) })); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "bankMap", 11)
 	}
 	return
 }
+
+// UnmarshalReuse - OthersTest
+func (othersTest *OthersTest) UnmarshalReuse(b []byte) (err error) {
+	_, err = othersTest.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - OthersTest
+func (othersTest *OthersTest) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	othersTest.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui", 1)
+	}
+	if ok {
+		if n, othersTest.Ui, err = bstd.UnmarshalUint(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64", 2)
+	}
+	if ok {
+		if n, othersTest.Ui64, err = bstd.UnmarshalUint64(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64", 2)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 3); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Arr", 3)
+	}
+	if ok {
+		if n, othersTest.Ui64Arr, err = bstd.UnmarshalUint64SliceIntoLimited(l, n, b, othersTest.Ui64Arr); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Arr", 3)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 4); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Map", 4)
+	}
+	if ok {
+		if n, othersTest.Ui64Map, err = bstd.UnmarshalMapIntoLimited[uint64, uint32](l, n, b, othersTest.Ui64Map, bstd.UnmarshalUint64, bstd.UnmarshalUint32); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Map", 4)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 5); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui32", 5)
+	}
+	if ok {
+		if n, othersTest.Ui32, err = bstd.UnmarshalUint32(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui32", 5)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 6); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui16", 6)
+	}
+	if ok {
+		if n, othersTest.Ui16, err = bstd.UnmarshalUint16(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui16", 6)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 7); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum", 7)
+	}
+	if ok {
+		if n, othersTest.ExampleEnum, err = bgenimpl.UnmarshalEnum[ExampleEnum](n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum", 7)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 8); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum2", 8)
+	}
+	if ok {
+		if n, othersTest.ExampleEnum2, err = bgenimpl.UnmarshalEnum[ExampleEnum2](n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum2", 8)
+		}
+	}
+	if n, err = othersTest.Person.NestedUnmarshalReuse(n, b, othersTestRIds, 9, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "person", 9)
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 10); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "person2", 10)
+	}
+	if ok {
+		if n, othersTest.Person2, err = bstd.UnmarshalSliceIntoLimited[[][]person.Person2](l, n, b, othersTest.Person2, bstd.ToUnmarshalIntoFunc(func(n int, b []byte) (int, [][]person.Person2, error) {
+			return bstd.UnmarshalSliceFuncLimited[[]person.Person2](l, n, b, func(n int, b []byte) (int, []person.Person2, error) {
+				return bstd.UnmarshalSliceIntoLimited[person.Person2](l, n, b, nil, func(n int, b []byte, s *person.Person2) (int, error) { return s.UnmarshalPlainReuse(n, b, l) })
+			})
+		})); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "person2", 10)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, othersTestRIds, 11); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "bankMap", 11)
+	}
+	if ok {
+		if n, othersTest.BankMap, err = bstd.UnmarshalMapIntoLimited[Bank, Citizen](l, n, b, othersTest.BankMap, bstd.ToUnmarshalFunc(func(n int, b []byte, s *Bank) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }), bstd.ToUnmarshalFunc(func(n int, b []byte, s *Citizen) (int, error) { return s.UnmarshalPlainLimited(n, b, l) })); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "OthersTest", "bankMap", 11)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - OthersTest
+func (othersTest *OthersTest) Reset() {
+	othersTest.Ui = 0
+	othersTest.Ui64 = 0
+	othersTest.Ui64Arr = othersTest.Ui64Arr[:0]
+	clear(othersTest.Ui64Map)
+	othersTest.Ui32 = 0
+	othersTest.Ui16 = 0
+	othersTest.ExampleEnum = 0
+	othersTest.ExampleEnum2 = 0
+	othersTest.Person.Reset()
+	othersTest.Person2 = othersTest.Person2[:0]
+	clear(othersTest.BankMap)
+}
+
+// UnmarshalPlainReuse - OthersTest
+func (othersTest *OthersTest) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, othersTest.Ui, err = bstd.UnmarshalUint(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui", 1)
+	}
+	if n, othersTest.Ui64, err = bstd.UnmarshalUint64(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64", 2)
+	}
+	if n, othersTest.Ui64Arr, err = bstd.UnmarshalUint64SliceIntoLimited(l, n, b, othersTest.Ui64Arr); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Arr", 3)
+	}
+	if n, othersTest.Ui64Map, err = bstd.UnmarshalMapIntoLimited[uint64, uint32](l, n, b, othersTest.Ui64Map, bstd.UnmarshalUint64, bstd.UnmarshalUint32); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui64Map", 4)
+	}
+	if n, othersTest.Ui32, err = bstd.UnmarshalUint32(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui32", 5)
+	}
+	if n, othersTest.Ui16, err = bstd.UnmarshalUint16(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "ui16", 6)
+	}
+	if n, othersTest.ExampleEnum, err = bgenimpl.UnmarshalEnum[ExampleEnum](n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum", 7)
+	}
+	if n, othersTest.ExampleEnum2, err = bgenimpl.UnmarshalEnum[ExampleEnum2](n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "exampleEnum2", 8)
+	}
+	if n, err = othersTest.Person.UnmarshalPlainReuse(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "person", 9)
+	}
+	if n, othersTest.Person2, err = bstd.UnmarshalSliceIntoLimited[[][]person.Person2](l, n, b, othersTest.Person2, bstd.ToUnmarshalIntoFunc(func(n int, b []byte) (int, [][]person.Person2, error) {
+		return bstd.UnmarshalSliceFuncLimited[[]person.Person2](l, n, b, func(n int, b []byte) (int, []person.Person2, error) {
+			return bstd.UnmarshalSliceIntoLimited[person.Person2](l, n, b, nil, func(n int, b []byte, s *person.Person2) (int, error) { return s.UnmarshalPlainReuse(n, b, l) })
+		})
+	})); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "person2", 10)
+	}
+	if n, othersTest.BankMap, err = bstd.UnmarshalMapIntoLimited[Bank, Citizen](l, n, b, othersTest.BankMap, bstd.ToUnmarshalFunc(func(n int, b []byte, s *Bank) (int, error) { return s.UnmarshalPlainLimited(n, b, l) }), bstd.ToUnmarshalFunc(func(n int, b []byte, s *Citizen) (int, error) { return s.UnmarshalPlainLimited(n, b, l) })); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "OthersTest", "bankMap", 11)
+	}
+	return
+}
+
+// MarshalSealed - OthersTest
+func (othersTest *OthersTest) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := othersTest.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - OthersTest
+func (othersTest *OthersTest) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return othersTest.Unmarshal(b)
+}
+
+// Struct - TimeTest
+type TimeTest struct {
+	CreatedAt time.Time
+	Ttl       time.Duration
+	History   []time.Time
+	Timeouts  map[string]time.Duration
+}
+
+// Reserved Ids - TimeTest
+var timeTestRIds = []uint16{}
+
+// Interfaces - TimeTest
+var _ benc.Message = (*TimeTest)(nil)
+var _ benc.PlainMessage = (*TimeTest)(nil)
+var _ benc.NestedMessage = (*TimeTest)(nil)
+
+// Size - TimeTest
+func (timeTest *TimeTest) Size() int {
+	return timeTest.NestedSize(0)
+}
+
+// Nested Size - TimeTest
+func (timeTest *TimeTest) NestedSize(id uint16) (s int) {
+	s += bstd.SizeTime() + 2
+	s += bstd.SizeDuration() + 2
+	s += bstd.SizeFixedSlice(timeTest.History, bstd.SizeTime()) + 2
+	s += bstd.SizeMapFunc(timeTest.Timeouts, bstd.SizeString, bstd.FixedSize[time.Duration](bstd.SizeDuration())) + 2
+
+	if id > 255 {
+		s += 5
+		return
+	}
+	s += 4
+	return
+}
+
+// SizePlain - TimeTest
+func (timeTest *TimeTest) SizePlain() (s int) {
+	s += bstd.SizeTime()
+	s += bstd.SizeDuration()
+	s += bstd.SizeFixedSlice(timeTest.History, bstd.SizeTime())
+	s += bstd.SizeMapFunc(timeTest.Timeouts, bstd.SizeString, bstd.FixedSize[time.Duration](bstd.SizeDuration()))
+	return
+}
+
+// Marshal - TimeTest
+func (timeTest *TimeTest) Marshal(b []byte) {
+	timeTest.NestedMarshal(0, b, 0)
+}
+
+// Nested Marshal - TimeTest
+func (timeTest *TimeTest) NestedMarshal(tn int, b []byte, id uint16) (n int) {
+	n = bgenimpl.MarshalTag(tn, b, bgenimpl.Container, id)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.Fixed128, 1)
+	n = bstd.MarshalTime(n, b, timeTest.CreatedAt)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.Fixed64, 2)
+	n = bstd.MarshalDuration(n, b, timeTest.Ttl)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 3)
+	n = bstd.MarshalSlice(n, b, timeTest.History, bstd.MarshalTime)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 4)
+	n = bstd.MarshalSortedMap(n, b, timeTest.Timeouts, bstd.MarshalString, bstd.MarshalDuration)
+
+	n += 2
+	b[n-2] = 1
+	b[n-1] = 1
+	return
+}
+
+// MarshalPlain - TimeTest
+func (timeTest *TimeTest) MarshalPlain(tn int, b []byte) (n int) {
+	n = tn
+	n = bstd.MarshalTime(n, b, timeTest.CreatedAt)
+	n = bstd.MarshalDuration(n, b, timeTest.Ttl)
+	n = bstd.MarshalSlice(n, b, timeTest.History, bstd.MarshalTime)
+	n = bstd.MarshalSortedMap(n, b, timeTest.Timeouts, bstd.MarshalString, bstd.MarshalDuration)
+	return n
+}
+
+// MarshalAppend - TimeTest
+func (timeTest *TimeTest) MarshalAppend(b []byte) []byte {
+	return timeTest.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - TimeTest
+func (timeTest *TimeTest) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed128, 1)
+	b = bstd.AppendTime(b, timeTest.CreatedAt)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed64, 2)
+	b = bstd.AppendDuration(b, timeTest.Ttl)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 3)
+	b = bstd.AppendSlice(b, timeTest.History, bstd.AppendTime)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 4)
+	b = bstd.AppendSortedMap(b, timeTest.Timeouts, bstd.AppendString, bstd.AppendDuration)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - TimeTest
+func (timeTest *TimeTest) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendTime(b, timeTest.CreatedAt)
+	b = bstd.AppendDuration(b, timeTest.Ttl)
+	b = bstd.AppendSlice(b, timeTest.History, bstd.AppendTime)
+	b = bstd.AppendSortedMap(b, timeTest.Timeouts, bstd.AppendString, bstd.AppendDuration)
+	return b
+}
+
+// Unmarshal - TimeTest
+func (timeTest *TimeTest) Unmarshal(b []byte) (err error) {
+	_, err = timeTest.NestedUnmarshal(0, b, []uint16{}, 0)
+	return
+}
+
+// UnmarshalLimited - TimeTest
+func (timeTest *TimeTest) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = timeTest.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
+// Nested Unmarshal - TimeTest
+func (timeTest *TimeTest) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return timeTest.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - TimeTest
+func (timeTest *TimeTest) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, timeTestRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "createdAt", 1)
+	}
+	if ok {
+		if n, timeTest.CreatedAt, err = bstd.UnmarshalTime(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "TimeTest", "createdAt", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, timeTestRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "ttl", 2)
+	}
+	if ok {
+		if n, timeTest.Ttl, err = bstd.UnmarshalDuration(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "TimeTest", "ttl", 2)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, timeTestRIds, 3); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "history", 3)
+	}
+	if ok {
+		if n, timeTest.History, err = bstd.UnmarshalSliceFuncLimited[time.Time](l, n, b, bstd.UnmarshalTime); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "TimeTest", "history", 3)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, timeTestRIds, 4); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "timeouts", 4)
+	}
+	if ok {
+		if n, timeTest.Timeouts, err = bstd.UnmarshalMapFuncLimited[string, time.Duration](l, n, b, l.UnmarshalString, bstd.UnmarshalDuration); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "TimeTest", "timeouts", 4)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// UnmarshalPlain - TimeTest
+func (timeTest *TimeTest) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return timeTest.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - TimeTest
+func (timeTest *TimeTest) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, timeTest.CreatedAt, err = bstd.UnmarshalTime(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "createdAt", 1)
+	}
+	if n, timeTest.Ttl, err = bstd.UnmarshalDuration(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "ttl", 2)
+	}
+	if n, timeTest.History, err = bstd.UnmarshalSliceFuncLimited[time.Time](l, n, b, bstd.UnmarshalTime); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "history", 3)
+	}
+	if n, timeTest.Timeouts, err = bstd.UnmarshalMapFuncLimited[string, time.Duration](l, n, b, l.UnmarshalString, bstd.UnmarshalDuration); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "timeouts", 4)
+	}
+	return
+}
+
+// UnmarshalReuse - TimeTest
+func (timeTest *TimeTest) UnmarshalReuse(b []byte) (err error) {
+	_, err = timeTest.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - TimeTest
+func (timeTest *TimeTest) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	timeTest.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, timeTestRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "createdAt", 1)
+	}
+	if ok {
+		if n, timeTest.CreatedAt, err = bstd.UnmarshalTime(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "TimeTest", "createdAt", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, timeTestRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "ttl", 2)
+	}
+	if ok {
+		if n, timeTest.Ttl, err = bstd.UnmarshalDuration(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "TimeTest", "ttl", 2)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, timeTestRIds, 3); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "history", 3)
+	}
+	if ok {
+		if n, timeTest.History, err = bstd.UnmarshalSliceIntoLimited[time.Time](l, n, b, timeTest.History, bstd.ToUnmarshalIntoFunc(bstd.UnmarshalTime)); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "TimeTest", "history", 3)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, timeTestRIds, 4); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "timeouts", 4)
+	}
+	if ok {
+		if n, timeTest.Timeouts, err = bstd.UnmarshalMapIntoLimited[string, time.Duration](l, n, b, timeTest.Timeouts, l.UnmarshalString, bstd.UnmarshalDuration); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "TimeTest", "timeouts", 4)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - TimeTest
+func (timeTest *TimeTest) Reset() {
+	timeTest.CreatedAt = time.Time{}
+	timeTest.Ttl = 0
+	timeTest.History = timeTest.History[:0]
+	clear(timeTest.Timeouts)
+}
+
+// UnmarshalPlainReuse - TimeTest
+func (timeTest *TimeTest) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, timeTest.CreatedAt, err = bstd.UnmarshalTime(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "createdAt", 1)
+	}
+	if n, timeTest.Ttl, err = bstd.UnmarshalDuration(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "ttl", 2)
+	}
+	if n, timeTest.History, err = bstd.UnmarshalSliceIntoLimited[time.Time](l, n, b, timeTest.History, bstd.ToUnmarshalIntoFunc(bstd.UnmarshalTime)); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "history", 3)
+	}
+	if n, timeTest.Timeouts, err = bstd.UnmarshalMapIntoLimited[string, time.Duration](l, n, b, timeTest.Timeouts, l.UnmarshalString, bstd.UnmarshalDuration); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "TimeTest", "timeouts", 4)
+	}
+	return
+}
+
+// MarshalSealed - TimeTest
+func (timeTest *TimeTest) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := timeTest.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - TimeTest
+func (timeTest *TimeTest) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return timeTest.Unmarshal(b)
+}
+
+// Struct - FlagsTest
+type FlagsTest struct {
+	Flags      []bool
+	FlagGroups [][]bool
+	Name       string
+}
+
+// Reserved Ids - FlagsTest
+var flagsTestRIds = []uint16{}
+
+// Interfaces - FlagsTest
+var _ benc.Message = (*FlagsTest)(nil)
+var _ benc.PlainMessage = (*FlagsTest)(nil)
+var _ benc.NestedMessage = (*FlagsTest)(nil)
+
+// Size - FlagsTest
+func (flagsTest *FlagsTest) Size() int {
+	return flagsTest.NestedSize(0)
+}
+
+// Nested Size - FlagsTest
+func (flagsTest *FlagsTest) NestedSize(id uint16) (s int) {
+	s += bstd.SizeBoolSlice(flagsTest.Flags) + 2
+	s += bstd.SizeSlice(flagsTest.FlagGroups, func(s []bool) int { return bstd.SizeFixedSlice(s, bstd.SizeBool()) }) + 2
+	s += bstd.SizeString(flagsTest.Name) + 2
+
+	if id > 255 {
+		s += 5
+		return
+	}
+	s += 4
+	return
+}
+
+// SizePlain - FlagsTest
+func (flagsTest *FlagsTest) SizePlain() (s int) {
+	s += bstd.SizeBoolSlice(flagsTest.Flags)
+	s += bstd.SizeSlice(flagsTest.FlagGroups, func(s []bool) int { return bstd.SizeFixedSlice(s, bstd.SizeBool()) })
+	s += bstd.SizeString(flagsTest.Name)
+	return
+}
+
+// Marshal - FlagsTest
+func (flagsTest *FlagsTest) Marshal(b []byte) {
+	flagsTest.NestedMarshal(0, b, 0)
+}
+
+// Nested Marshal - FlagsTest
+func (flagsTest *FlagsTest) NestedMarshal(tn int, b []byte, id uint16) (n int) {
+	n = bgenimpl.MarshalTag(tn, b, bgenimpl.Container, id)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.PackedBools, 1)
+	n = bstd.MarshalBoolSlice(n, b, flagsTest.Flags)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 2)
+	n = bstd.MarshalSlice(n, b, flagsTest.FlagGroups, func(n int, b []byte, s []bool) int { return bstd.MarshalSlice(n, b, s, bstd.MarshalBool) })
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.Bytes, 3)
+	n = bstd.MarshalString(n, b, flagsTest.Name)
+
+	n += 2
+	b[n-2] = 1
+	b[n-1] = 1
+	return
+}
+
+// MarshalPlain - FlagsTest
+func (flagsTest *FlagsTest) MarshalPlain(tn int, b []byte) (n int) {
+	n = tn
+	n = bstd.MarshalBoolSlice(n, b, flagsTest.Flags)
+	n = bstd.MarshalSlice(n, b, flagsTest.FlagGroups, func(n int, b []byte, s []bool) int { return bstd.MarshalSlice(n, b, s, bstd.MarshalBool) })
+	n = bstd.MarshalString(n, b, flagsTest.Name)
+	return n
+}
+
+// MarshalAppend - FlagsTest
+func (flagsTest *FlagsTest) MarshalAppend(b []byte) []byte {
+	return flagsTest.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - FlagsTest
+func (flagsTest *FlagsTest) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.PackedBools, 1)
+	b = bstd.AppendBoolSlice(b, flagsTest.Flags)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 2)
+	b = bstd.AppendSlice(b, flagsTest.FlagGroups, func(b []byte, s []bool) []byte { return bstd.AppendSlice(b, s, bstd.AppendBool) })
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 3)
+	b = bstd.AppendString(b, flagsTest.Name)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - FlagsTest
+func (flagsTest *FlagsTest) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendBoolSlice(b, flagsTest.Flags)
+	b = bstd.AppendSlice(b, flagsTest.FlagGroups, func(b []byte, s []bool) []byte { return bstd.AppendSlice(b, s, bstd.AppendBool) })
+	b = bstd.AppendString(b, flagsTest.Name)
+	return b
+}
+
+// Unmarshal - FlagsTest
+func (flagsTest *FlagsTest) Unmarshal(b []byte) (err error) {
+	_, err = flagsTest.NestedUnmarshal(0, b, []uint16{}, 0)
+	return
+}
+
+// UnmarshalLimited - FlagsTest
+func (flagsTest *FlagsTest) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = flagsTest.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
+// Nested Unmarshal - FlagsTest
+func (flagsTest *FlagsTest) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return flagsTest.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - FlagsTest
+func (flagsTest *FlagsTest) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	var ok bool
+	var typ byte
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return
+	}
+	if n, typ, ok, err = bgenimpl.HandleCompatibilityType(n, b, flagsTestRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flags", 1)
+	}
+	if ok {
+		if n, flagsTest.Flags, err = bgenimpl.UnmarshalBoolSliceField(l, typ, n, b, nil); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flags", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, flagsTestRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flagGroups", 2)
+	}
+	if ok {
+		if n, flagsTest.FlagGroups, err = bstd.UnmarshalSliceFuncLimited[[]bool](l, n, b, func(n int, b []byte) (int, []bool, error) {
+			return bstd.UnmarshalSliceFuncLimited[bool](l, n, b, bstd.UnmarshalBool)
+		}); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flagGroups", 2)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, flagsTestRIds, 3); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "name", 3)
+	}
+	if ok {
+		if n, flagsTest.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "name", 3)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// UnmarshalPlain - FlagsTest
+func (flagsTest *FlagsTest) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return flagsTest.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - FlagsTest
+func (flagsTest *FlagsTest) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, flagsTest.Flags, err = bstd.UnmarshalBoolSliceIntoLimited(l, n, b, nil); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flags", 1)
+	}
+	if n, flagsTest.FlagGroups, err = bstd.UnmarshalSliceFuncLimited[[]bool](l, n, b, func(n int, b []byte) (int, []bool, error) {
+		return bstd.UnmarshalSliceFuncLimited[bool](l, n, b, bstd.UnmarshalBool)
+	}); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flagGroups", 2)
+	}
+	if n, flagsTest.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "name", 3)
+	}
+	return
+}
+
+// UnmarshalReuse - FlagsTest
+func (flagsTest *FlagsTest) UnmarshalReuse(b []byte) (err error) {
+	_, err = flagsTest.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - FlagsTest
+func (flagsTest *FlagsTest) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	flagsTest.Reset()
+	var ok bool
+	var typ byte
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return
+	}
+	if n, typ, ok, err = bgenimpl.HandleCompatibilityType(n, b, flagsTestRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flags", 1)
+	}
+	if ok {
+		if n, flagsTest.Flags, err = bgenimpl.UnmarshalBoolSliceField(l, typ, n, b, flagsTest.Flags); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flags", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, flagsTestRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flagGroups", 2)
+	}
+	if ok {
+		if n, flagsTest.FlagGroups, err = bstd.UnmarshalSliceIntoLimited[[]bool](l, n, b, flagsTest.FlagGroups, bstd.ToUnmarshalIntoFunc(func(n int, b []byte) (int, []bool, error) {
+			return bstd.UnmarshalSliceFuncLimited[bool](l, n, b, bstd.UnmarshalBool)
+		})); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flagGroups", 2)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, flagsTestRIds, 3); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "name", 3)
+	}
+	if ok {
+		if n, flagsTest.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "name", 3)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - FlagsTest
+func (flagsTest *FlagsTest) Reset() {
+	flagsTest.Flags = flagsTest.Flags[:0]
+	flagsTest.FlagGroups = flagsTest.FlagGroups[:0]
+	flagsTest.Name = ""
+}
+
+// UnmarshalPlainReuse - FlagsTest
+func (flagsTest *FlagsTest) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, flagsTest.Flags, err = bstd.UnmarshalBoolSliceIntoLimited(l, n, b, flagsTest.Flags); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flags", 1)
+	}
+	if n, flagsTest.FlagGroups, err = bstd.UnmarshalSliceIntoLimited[[]bool](l, n, b, flagsTest.FlagGroups, bstd.ToUnmarshalIntoFunc(func(n int, b []byte) (int, []bool, error) {
+		return bstd.UnmarshalSliceFuncLimited[bool](l, n, b, bstd.UnmarshalBool)
+	})); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "flagGroups", 2)
+	}
+	if n, flagsTest.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "FlagsTest", "name", 3)
+	}
+	return
+}
+
+// MarshalSealed - FlagsTest
+func (flagsTest *FlagsTest) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := flagsTest.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - FlagsTest
+func (flagsTest *FlagsTest) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return flagsTest.Unmarshal(b)
+}
+
+// Struct - DeltaTest
+type DeltaTest struct {
+	Timestamps []int64
+	Ids        []uint16
+	Plain      []int64
+}
+
+// Reserved Ids - DeltaTest
+var deltaTestRIds = []uint16{}
+
+// Interfaces - DeltaTest
+var _ benc.Message = (*DeltaTest)(nil)
+var _ benc.PlainMessage = (*DeltaTest)(nil)
+var _ benc.NestedMessage = (*DeltaTest)(nil)
+
+// Size - DeltaTest
+func (deltaTest *DeltaTest) Size() int {
+	return deltaTest.NestedSize(0)
+}
+
+// Nested Size - DeltaTest
+func (deltaTest *DeltaTest) NestedSize(id uint16) (s int) {
+	s += bstd.SizeDeltaSlice(deltaTest.Timestamps) + 2
+	s += bstd.SizeDeltaSlice(deltaTest.Ids) + 2
+	s += bstd.SizeFixedSlice(deltaTest.Plain, bstd.SizeInt64()) + 2
+
+	if id > 255 {
+		s += 5
+		return
+	}
+	s += 4
+	return
+}
+
+// SizePlain - DeltaTest
+func (deltaTest *DeltaTest) SizePlain() (s int) {
+	s += bstd.SizeDeltaSlice(deltaTest.Timestamps)
+	s += bstd.SizeDeltaSlice(deltaTest.Ids)
+	s += bstd.SizeFixedSlice(deltaTest.Plain, bstd.SizeInt64())
+	return
+}
+
+// Marshal - DeltaTest
+func (deltaTest *DeltaTest) Marshal(b []byte) {
+	deltaTest.NestedMarshal(0, b, 0)
+}
+
+// Nested Marshal - DeltaTest
+func (deltaTest *DeltaTest) NestedMarshal(tn int, b []byte, id uint16) (n int) {
+	n = bgenimpl.MarshalTag(tn, b, bgenimpl.Container, id)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 1)
+	n = bstd.MarshalDeltaSlice(n, b, deltaTest.Timestamps)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 2)
+	n = bstd.MarshalDeltaSlice(n, b, deltaTest.Ids)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 3)
+	n = bstd.MarshalInt64Slice(n, b, deltaTest.Plain)
+
+	n += 2
+	b[n-2] = 1
+	b[n-1] = 1
+	return
+}
+
+// MarshalPlain - DeltaTest
+func (deltaTest *DeltaTest) MarshalPlain(tn int, b []byte) (n int) {
+	n = tn
+	n = bstd.MarshalDeltaSlice(n, b, deltaTest.Timestamps)
+	n = bstd.MarshalDeltaSlice(n, b, deltaTest.Ids)
+	n = bstd.MarshalInt64Slice(n, b, deltaTest.Plain)
+	return n
+}
+
+// MarshalAppend - DeltaTest
+func (deltaTest *DeltaTest) MarshalAppend(b []byte) []byte {
+	return deltaTest.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - DeltaTest
+func (deltaTest *DeltaTest) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 1)
+	b = bstd.AppendDeltaSlice(b, deltaTest.Timestamps)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 2)
+	b = bstd.AppendDeltaSlice(b, deltaTest.Ids)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 3)
+	b = bstd.AppendInt64Slice(b, deltaTest.Plain)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - DeltaTest
+func (deltaTest *DeltaTest) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendDeltaSlice(b, deltaTest.Timestamps)
+	b = bstd.AppendDeltaSlice(b, deltaTest.Ids)
+	b = bstd.AppendInt64Slice(b, deltaTest.Plain)
+	return b
+}
+
+// Unmarshal - DeltaTest
+func (deltaTest *DeltaTest) Unmarshal(b []byte) (err error) {
+	_, err = deltaTest.NestedUnmarshal(0, b, []uint16{}, 0)
+	return
+}
+
+// UnmarshalLimited - DeltaTest
+func (deltaTest *DeltaTest) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = deltaTest.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
+// Nested Unmarshal - DeltaTest
+func (deltaTest *DeltaTest) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return deltaTest.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - DeltaTest
+func (deltaTest *DeltaTest) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, deltaTestRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "timestamps", 1)
+	}
+	if ok {
+		if n, deltaTest.Timestamps, err = bstd.UnmarshalDeltaSliceLimited[int64](l, n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "timestamps", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, deltaTestRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "ids", 2)
+	}
+	if ok {
+		if n, deltaTest.Ids, err = bstd.UnmarshalDeltaSliceLimited[uint16](l, n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "ids", 2)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, deltaTestRIds, 3); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "plain", 3)
+	}
+	if ok {
+		if n, deltaTest.Plain, err = bstd.UnmarshalInt64SliceLimited(l, n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "plain", 3)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// UnmarshalPlain - DeltaTest
+func (deltaTest *DeltaTest) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return deltaTest.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - DeltaTest
+func (deltaTest *DeltaTest) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, deltaTest.Timestamps, err = bstd.UnmarshalDeltaSliceLimited[int64](l, n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "timestamps", 1)
+	}
+	if n, deltaTest.Ids, err = bstd.UnmarshalDeltaSliceLimited[uint16](l, n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "ids", 2)
+	}
+	if n, deltaTest.Plain, err = bstd.UnmarshalInt64SliceLimited(l, n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "plain", 3)
+	}
+	return
+}
+
+// UnmarshalReuse - DeltaTest
+func (deltaTest *DeltaTest) UnmarshalReuse(b []byte) (err error) {
+	_, err = deltaTest.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - DeltaTest
+func (deltaTest *DeltaTest) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	deltaTest.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, deltaTestRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "timestamps", 1)
+	}
+	if ok {
+		if n, deltaTest.Timestamps, err = bstd.UnmarshalDeltaSliceIntoLimited(l, n, b, deltaTest.Timestamps); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "timestamps", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, deltaTestRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "ids", 2)
+	}
+	if ok {
+		if n, deltaTest.Ids, err = bstd.UnmarshalDeltaSliceIntoLimited(l, n, b, deltaTest.Ids); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "ids", 2)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, deltaTestRIds, 3); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "plain", 3)
+	}
+	if ok {
+		if n, deltaTest.Plain, err = bstd.UnmarshalInt64SliceIntoLimited(l, n, b, deltaTest.Plain); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "plain", 3)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - DeltaTest
+func (deltaTest *DeltaTest) Reset() {
+	deltaTest.Timestamps = deltaTest.Timestamps[:0]
+	deltaTest.Ids = deltaTest.Ids[:0]
+	deltaTest.Plain = deltaTest.Plain[:0]
+}
+
+// UnmarshalPlainReuse - DeltaTest
+func (deltaTest *DeltaTest) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, deltaTest.Timestamps, err = bstd.UnmarshalDeltaSliceIntoLimited(l, n, b, deltaTest.Timestamps); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "timestamps", 1)
+	}
+	if n, deltaTest.Ids, err = bstd.UnmarshalDeltaSliceIntoLimited(l, n, b, deltaTest.Ids); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "ids", 2)
+	}
+	if n, deltaTest.Plain, err = bstd.UnmarshalInt64SliceIntoLimited(l, n, b, deltaTest.Plain); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "DeltaTest", "plain", 3)
+	}
+	return
+}
+
+// MarshalSealed - DeltaTest
+func (deltaTest *DeltaTest) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := deltaTest.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - DeltaTest
+func (deltaTest *DeltaTest) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return deltaTest.Unmarshal(b)
+}
+
+// Struct - GorillaTest
+type GorillaTest struct {
+	Readings []float64
+	Plain    []float64
+}
+
+// Reserved Ids - GorillaTest
+var gorillaTestRIds = []uint16{}
+
+// Interfaces - GorillaTest
+var _ benc.Message = (*GorillaTest)(nil)
+var _ benc.PlainMessage = (*GorillaTest)(nil)
+var _ benc.NestedMessage = (*GorillaTest)(nil)
+
+// Size - GorillaTest
+func (gorillaTest *GorillaTest) Size() int {
+	return gorillaTest.NestedSize(0)
+}
+
+// Nested Size - GorillaTest
+func (gorillaTest *GorillaTest) NestedSize(id uint16) (s int) {
+	s += bstd.SizeGorillaSlice(gorillaTest.Readings) + 2
+	s += bstd.SizeFixedSlice(gorillaTest.Plain, bstd.SizeFloat64()) + 2
+
+	if id > 255 {
+		s += 5
+		return
+	}
+	s += 4
+	return
+}
+
+// SizePlain - GorillaTest
+func (gorillaTest *GorillaTest) SizePlain() (s int) {
+	s += bstd.SizeGorillaSlice(gorillaTest.Readings)
+	s += bstd.SizeFixedSlice(gorillaTest.Plain, bstd.SizeFloat64())
+	return
+}
+
+// Marshal - GorillaTest
+func (gorillaTest *GorillaTest) Marshal(b []byte) {
+	gorillaTest.NestedMarshal(0, b, 0)
+}
+
+// Nested Marshal - GorillaTest
+func (gorillaTest *GorillaTest) NestedMarshal(tn int, b []byte, id uint16) (n int) {
+	n = bgenimpl.MarshalTag(tn, b, bgenimpl.Container, id)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 1)
+	n = bstd.MarshalGorillaSlice(n, b, gorillaTest.Readings)
+	n = bgenimpl.MarshalTag(n, b, bgenimpl.ArrayMap, 2)
+	n = bstd.MarshalFloat64Slice(n, b, gorillaTest.Plain)
+
+	n += 2
+	b[n-2] = 1
+	b[n-1] = 1
+	return
+}
+
+// MarshalPlain - GorillaTest
+func (gorillaTest *GorillaTest) MarshalPlain(tn int, b []byte) (n int) {
+	n = tn
+	n = bstd.MarshalGorillaSlice(n, b, gorillaTest.Readings)
+	n = bstd.MarshalFloat64Slice(n, b, gorillaTest.Plain)
+	return n
+}
+
+// MarshalAppend - GorillaTest
+func (gorillaTest *GorillaTest) MarshalAppend(b []byte) []byte {
+	return gorillaTest.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - GorillaTest
+func (gorillaTest *GorillaTest) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 1)
+	b = bstd.AppendGorillaSlice(b, gorillaTest.Readings)
+	b = bgenimpl.AppendTag(b, bgenimpl.ArrayMap, 2)
+	b = bstd.AppendFloat64Slice(b, gorillaTest.Plain)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - GorillaTest
+func (gorillaTest *GorillaTest) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendGorillaSlice(b, gorillaTest.Readings)
+	b = bstd.AppendFloat64Slice(b, gorillaTest.Plain)
+	return b
+}
+
+// Unmarshal - GorillaTest
+func (gorillaTest *GorillaTest) Unmarshal(b []byte) (err error) {
+	_, err = gorillaTest.NestedUnmarshal(0, b, []uint16{}, 0)
+	return
+}
+
+// UnmarshalLimited - GorillaTest
+func (gorillaTest *GorillaTest) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = gorillaTest.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
+// Nested Unmarshal - GorillaTest
+func (gorillaTest *GorillaTest) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return gorillaTest.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - GorillaTest
+func (gorillaTest *GorillaTest) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, gorillaTestRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "readings", 1)
+	}
+	if ok {
+		if n, gorillaTest.Readings, err = bstd.UnmarshalGorillaSliceLimited(l, n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "readings", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, gorillaTestRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "plain", 2)
+	}
+	if ok {
+		if n, gorillaTest.Plain, err = bstd.UnmarshalFloat64SliceLimited(l, n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "plain", 2)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// UnmarshalPlain - GorillaTest
+func (gorillaTest *GorillaTest) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return gorillaTest.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - GorillaTest
+func (gorillaTest *GorillaTest) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, gorillaTest.Readings, err = bstd.UnmarshalGorillaSliceLimited(l, n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "readings", 1)
+	}
+	if n, gorillaTest.Plain, err = bstd.UnmarshalFloat64SliceLimited(l, n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "plain", 2)
+	}
+	return
+}
+
+// UnmarshalReuse - GorillaTest
+func (gorillaTest *GorillaTest) UnmarshalReuse(b []byte) (err error) {
+	_, err = gorillaTest.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - GorillaTest
+func (gorillaTest *GorillaTest) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	gorillaTest.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, gorillaTestRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "readings", 1)
+	}
+	if ok {
+		if n, gorillaTest.Readings, err = bstd.UnmarshalGorillaSliceIntoLimited(l, n, b, gorillaTest.Readings); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "readings", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, gorillaTestRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "plain", 2)
+	}
+	if ok {
+		if n, gorillaTest.Plain, err = bstd.UnmarshalFloat64SliceIntoLimited(l, n, b, gorillaTest.Plain); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "plain", 2)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - GorillaTest
+func (gorillaTest *GorillaTest) Reset() {
+	gorillaTest.Readings = gorillaTest.Readings[:0]
+	gorillaTest.Plain = gorillaTest.Plain[:0]
+}
+
+// UnmarshalPlainReuse - GorillaTest
+func (gorillaTest *GorillaTest) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, gorillaTest.Readings, err = bstd.UnmarshalGorillaSliceIntoLimited(l, n, b, gorillaTest.Readings); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "readings", 1)
+	}
+	if n, gorillaTest.Plain, err = bstd.UnmarshalFloat64SliceIntoLimited(l, n, b, gorillaTest.Plain); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "GorillaTest", "plain", 2)
+	}
+	return
+}
+
+// MarshalSealed - GorillaTest
+func (gorillaTest *GorillaTest) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := gorillaTest.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - GorillaTest
+func (gorillaTest *GorillaTest) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return gorillaTest.Unmarshal(b)
+}
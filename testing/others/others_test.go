@@ -1,12 +1,17 @@
-//go:generate bencgen --in ../schemas/others.benc --out ./ --file ... --lang go --import-dir ../schemas
+//go:generate bencgen --in ../schemas/others.benc --out ./ --file ... --lang go --import-dir ../schemas --canonical
 
 package others
 
 import (
+	"bytes"
 	"math/rand"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/deneonet/benc"
+	bgenimpl "github.com/deneonet/benc/impl/gen"
+	bstd "github.com/deneonet/benc/std"
 	"github.com/deneonet/benc/testing/person"
 )
 
@@ -59,3 +64,192 @@ func TestUint(t *testing.T) {
 		t.Errorf("Deserialized- and original data don't match!")
 	}
 }
+
+func TestTime(t *testing.T) {
+	data := TimeTest{
+		CreatedAt: time.Date(2024, 5, 17, 13, 37, 0, 123456789, time.UTC),
+		Ttl:       90 * time.Minute,
+		History: []time.Time{
+			{},
+			time.Date(1969, 12, 31, 23, 59, 59, 1, time.UTC),
+		},
+		Timeouts: map[string]time.Duration{"read": -5 * time.Second},
+	}
+
+	if err := benc.VerifyRoundTrip(&data); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, data.Size())
+	data.Marshal(buf)
+
+	var deserData TimeTest
+	if err := deserData.Unmarshal(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(deserData, data) {
+		t.Logf("%v", deserData)
+		t.Logf("%v", data)
+		t.Errorf("Deserialized- and original data don't match!")
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	ui64Map := make(map[uint64]uint32)
+	for i := 0; i < 50; i++ {
+		ui64Map[rand.Uint64()] = rand.Uint32()
+	}
+
+	bankMap := make(map[Bank]Citizen)
+	for _, name := range []string{"VR Bank", "Sparkasse", "Volksbank", "Postbank", "Commerzbank"} {
+		bankMap[Bank{Name: name}] = Citizen{Name: name + " Citizen"}
+	}
+
+	data := OthersTest{
+		Ui64Map: ui64Map,
+		BankMap: bankMap,
+	}
+
+	buf := make([]byte, data.Size())
+	data.Marshal(buf)
+
+	for i := 0; i < 10; i++ {
+		retBuf := make([]byte, data.Size())
+		data.Marshal(retBuf)
+		if !bytes.Equal(retBuf, buf) {
+			t.Fatal("Marshal is not deterministic")
+		}
+		if !bytes.Equal(data.MarshalAppend(nil), buf) {
+			t.Fatal("MarshalAppend is not deterministic")
+		}
+	}
+
+	// Canonical maps marshal to the same bytes again, after a round trip
+	if err := benc.VerifyRoundTrip(&data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPackedBools(t *testing.T) {
+	flags := make([]bool, 1000)
+	for i := range flags {
+		flags[i] = i%3 == 0
+	}
+
+	data := FlagsTest{
+		Flags:      flags,
+		FlagGroups: [][]bool{{true, false}, {}},
+		Name:       "flags",
+	}
+
+	buf := make([]byte, data.Size())
+	data.Marshal(buf)
+	if len(buf) > 200 {
+		t.Fatalf("expected the bools to be packed, got %d bytes", len(buf))
+	}
+
+	var deserData FlagsTest
+	if err := deserData.Unmarshal(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deserData, data) {
+		t.Logf("%v", deserData)
+		t.Logf("%v", data)
+		t.Errorf("Deserialized- and original data don't match!")
+	}
+
+	// Marshalled by an older bencgen, that didn't pack the bools
+	old := []bool{true, false, true}
+	buf = bgenimpl.AppendTag(nil, bgenimpl.Container, 0)
+	buf = bgenimpl.AppendTag(buf, bgenimpl.ArrayMap, 1)
+	buf = bstd.AppendSlice(buf, old, bstd.AppendBool)
+	buf = bgenimpl.AppendTag(buf, bgenimpl.Bytes, 3)
+	buf = bstd.AppendString(buf, "old")
+	buf = append(buf, 1, 1)
+
+	deserData = FlagsTest{}
+	if err := deserData.Unmarshal(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deserData.Flags, old) || deserData.Name != "old" {
+		t.Errorf("unexpected %v", deserData)
+	}
+
+	// Marshalled by bencgen v1: the element count, one byte per bool and the terminator, without the collection header
+	buf = bgenimpl.AppendTag(nil, bgenimpl.Container, 0)
+	buf = bgenimpl.AppendTag(buf, bgenimpl.ArrayMap, 1)
+	buf = append(buf, 3, 1, 0, 1, 1, 1, 1, 1)
+	buf = bgenimpl.AppendTag(buf, bgenimpl.Bytes, 3)
+	buf = bstd.AppendString(buf, "v1")
+	buf = append(buf, 1, 1)
+
+	for _, unmarshal := range []func([]byte) error{deserData.Unmarshal, deserData.UnmarshalReuse} {
+		deserData = FlagsTest{}
+		if err := unmarshal(buf); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(deserData.Flags, old) || deserData.Name != "v1" {
+			t.Errorf("unexpected %v", deserData)
+		}
+	}
+}
+
+func TestDelta(t *testing.T) {
+	data := DeltaTest{
+		Timestamps: make([]int64, 100),
+		Ids:        []uint16{1, 2, 3, 65535, 0},
+		Plain:      make([]int64, 100),
+	}
+	for i := range data.Timestamps {
+		data.Timestamps[i] = 1717171717000 + int64(i)*250
+		data.Plain[i] = data.Timestamps[i]
+	}
+
+	buf := make([]byte, data.Size())
+	data.Marshal(buf)
+
+	// The deltas need two bytes at most, the plain elements eight bytes
+	if s := bstd.SizeDeltaSlice(data.Timestamps); s*3 > bstd.SizeFixedSlice(data.Plain, bstd.SizeInt64()) {
+		t.Fatalf("expected the delta slice to be smaller, got %d bytes", s)
+	}
+
+	var deserData DeltaTest
+	if err := deserData.Unmarshal(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deserData, data) {
+		t.Logf("%v", deserData)
+		t.Logf("%v", data)
+		t.Errorf("Deserialized- and original data don't match!")
+	}
+}
+
+func TestGorilla(t *testing.T) {
+	data := GorillaTest{
+		Readings: make([]float64, 100),
+		Plain:    make([]float64, 100),
+	}
+	for i := range data.Readings {
+		data.Readings[i] = 21.5 + float64(i/10)*0.25
+		data.Plain[i] = data.Readings[i]
+	}
+
+	buf := make([]byte, data.Size())
+	data.Marshal(buf)
+
+	// Repeated readings need a single bit, the plain elements eight bytes
+	if s := bstd.SizeGorillaSlice(data.Readings); s*4 > bstd.SizeFixedSlice(data.Plain, bstd.SizeFloat64()) {
+		t.Fatalf("expected the gorilla slice to be smaller, got %d bytes", s)
+	}
+
+	var deserData GorillaTest
+	if err := deserData.Unmarshal(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deserData, data) {
+		t.Logf("%v", deserData)
+		t.Logf("%v", data)
+		t.Errorf("Deserialized- and original data don't match!")
+	}
+}
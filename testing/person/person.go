@@ -4,6 +4,8 @@
 package person
 
 import (
+	"github.com/deneonet/benc"
+	"github.com/deneonet/benc/frame"
 	"github.com/deneonet/benc/impl/gen"
 	"github.com/deneonet/benc/std"
 )
@@ -19,6 +21,11 @@ type Person struct {
 // Reserved Ids - Person
 var personRIds = []uint16{}
 
+// Interfaces - Person
+var _ benc.Message = (*Person)(nil)
+var _ benc.PlainMessage = (*Person)(nil)
+var _ benc.NestedMessage = (*Person)(nil)
+
 // Size - Person
 func (person *Person) Size() int {
 	return person.NestedSize(0)
@@ -79,14 +86,52 @@ func (person *Person) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - Person
+func (person *Person) MarshalAppend(b []byte) []byte {
+	return person.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - Person
+func (person *Person) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed8, 1)
+	b = bstd.AppendByte(b, person.Age)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 2)
+	b = bstd.AppendString(b, person.Name)
+	b = person.Parents.NestedMarshalAppend(b, 3)
+	b = person.Child.NestedMarshalAppend(b, 4)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - Person
+func (person *Person) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendByte(b, person.Age)
+	b = bstd.AppendString(b, person.Name)
+	b = person.Parents.MarshalPlainAppend(b)
+	b = person.Child.MarshalPlainAppend(b)
+	return b
+}
+
 // Unmarshal - Person
 func (person *Person) Unmarshal(b []byte) (err error) {
 	_, err = person.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - Person
+func (person *Person) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = person.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - Person
 func (person *Person) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return person.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - Person
+func (person *Person) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -98,50 +143,163 @@ func (person *Person) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Person", "age", 1)
 	}
 	if ok {
 		if n, person.Age, err = bstd.UnmarshalByte(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "Person", "age", 1)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, personRIds, 2); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Person", "name", 2)
 	}
 	if ok {
-		if n, person.Name, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, person.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Person", "name", 2)
 		}
 	}
-	if n, err = person.Parents.NestedUnmarshal(n, b, personRIds, 3); err != nil {
-		return
+	if n, err = person.Parents.NestedUnmarshalLimited(n, b, personRIds, 3, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "parents", 3)
 	}
-	if n, err = person.Child.NestedUnmarshal(n, b, personRIds, 4); err != nil {
-		return
+	if n, err = person.Child.NestedUnmarshalLimited(n, b, personRIds, 4, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "child", 4)
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - Person
 func (person *Person) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return person.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - Person
+func (person *Person) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
 	if n, person.Age, err = bstd.UnmarshalByte(n, b); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Person", "age", 1)
 	}
-	if n, person.Name, err = bstd.UnmarshalString(n, b); err != nil {
+	if n, person.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "name", 2)
+	}
+	if n, err = person.Parents.UnmarshalPlainLimited(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "parents", 3)
+	}
+	if n, err = person.Child.UnmarshalPlainLimited(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "child", 4)
+	}
+	return
+}
+
+// UnmarshalReuse - Person
+func (person *Person) UnmarshalReuse(b []byte) (err error) {
+	_, err = person.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - Person
+func (person *Person) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	person.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
-	if n, err = person.Parents.UnmarshalPlain(n, b); err != nil {
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, personRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Person", "age", 1)
+	}
+	if ok {
+		if n, person.Age, err = bstd.UnmarshalByte(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Person", "age", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, personRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Person", "name", 2)
+	}
+	if ok {
+		if n, person.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Person", "name", 2)
+		}
+	}
+	if n, err = person.Parents.NestedUnmarshalReuse(n, b, personRIds, 3, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "parents", 3)
+	}
+	if n, err = person.Child.NestedUnmarshalReuse(n, b, personRIds, 4, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "child", 4)
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - Person
+func (person *Person) Reset() {
+	person.Age = 0
+	person.Name = ""
+	person.Parents.Reset()
+	person.Child.Reset()
+}
+
+// UnmarshalPlainReuse - Person
+func (person *Person) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, person.Age, err = bstd.UnmarshalByte(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "age", 1)
+	}
+	if n, person.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "name", 2)
+	}
+	if n, err = person.Parents.UnmarshalPlainReuse(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "parents", 3)
+	}
+	if n, err = person.Child.UnmarshalPlainReuse(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person", "child", 4)
+	}
+	return
+}
+
+// SizeFramed - Person
+func (person *Person) SizeFramed() int {
+	return bframe.Size(person.Size())
+}
+
+// MarshalFramed - Person
+func (person *Person) MarshalFramed(b []byte) {
+	start := bframe.Reserve(0, b)
+	bframe.Finish(start, person.NestedMarshal(start, b, 0), b)
+}
+
+// UnmarshalFramed - Person
+func (person *Person) UnmarshalFramed(b []byte) (err error) {
+	var body []byte
+	if _, body, err = bframe.Unmarshal(0, b); err != nil {
 		return
 	}
-	if n, err = person.Child.UnmarshalPlain(n, b); err != nil {
+	return person.Unmarshal(body)
+}
+
+// MarshalSealed - Person
+func (person *Person) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := person.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - Person
+func (person *Person) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
 		return
 	}
-	return
+	return person.Unmarshal(b)
 }
 
 // Struct - Child
@@ -154,6 +312,11 @@ type Child struct {
 // Reserved Ids - Child
 var childRIds = []uint16{}
 
+// Interfaces - Child
+var _ benc.Message = (*Child)(nil)
+var _ benc.PlainMessage = (*Child)(nil)
+var _ benc.NestedMessage = (*Child)(nil)
+
 // Size - Child
 func (child *Child) Size() int {
 	return child.NestedSize(0)
@@ -210,14 +373,50 @@ func (child *Child) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - Child
+func (child *Child) MarshalAppend(b []byte) []byte {
+	return child.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - Child
+func (child *Child) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed8, 1)
+	b = bstd.AppendByte(b, child.Age)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 2)
+	b = bstd.AppendString(b, child.Name)
+	b = child.Parents.NestedMarshalAppend(b, 3)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - Child
+func (child *Child) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendByte(b, child.Age)
+	b = bstd.AppendString(b, child.Name)
+	b = child.Parents.MarshalPlainAppend(b)
+	return b
+}
+
 // Unmarshal - Child
 func (child *Child) Unmarshal(b []byte) (err error) {
 	_, err = child.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - Child
+func (child *Child) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = child.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - Child
 func (child *Child) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return child.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - Child
+func (child *Child) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -229,44 +428,150 @@ func (child *Child) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Child", "age", 1)
 	}
 	if ok {
 		if n, child.Age, err = bstd.UnmarshalByte(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "Child", "age", 1)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, childRIds, 2); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Child", "name", 2)
 	}
 	if ok {
-		if n, child.Name, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, child.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Child", "name", 2)
 		}
 	}
-	if n, err = child.Parents.NestedUnmarshal(n, b, childRIds, 3); err != nil {
-		return
+	if n, err = child.Parents.NestedUnmarshalLimited(n, b, childRIds, 3, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child", "parents", 3)
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - Child
 func (child *Child) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return child.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - Child
+func (child *Child) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
 	if n, child.Age, err = bstd.UnmarshalByte(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child", "age", 1)
+	}
+	if n, child.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child", "name", 2)
+	}
+	if n, err = child.Parents.UnmarshalPlainLimited(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child", "parents", 3)
+	}
+	return
+}
+
+// UnmarshalReuse - Child
+func (child *Child) UnmarshalReuse(b []byte) (err error) {
+	_, err = child.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - Child
+func (child *Child) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	child.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
-	if n, child.Name, err = bstd.UnmarshalString(n, b); err != nil {
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, childRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Child", "age", 1)
+	}
+	if ok {
+		if n, child.Age, err = bstd.UnmarshalByte(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Child", "age", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, childRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Child", "name", 2)
+	}
+	if ok {
+		if n, child.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Child", "name", 2)
+		}
+	}
+	if n, err = child.Parents.NestedUnmarshalReuse(n, b, childRIds, 3, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child", "parents", 3)
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - Child
+func (child *Child) Reset() {
+	child.Age = 0
+	child.Name = ""
+	child.Parents.Reset()
+}
+
+// UnmarshalPlainReuse - Child
+func (child *Child) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, child.Age, err = bstd.UnmarshalByte(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child", "age", 1)
+	}
+	if n, child.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child", "name", 2)
+	}
+	if n, err = child.Parents.UnmarshalPlainReuse(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child", "parents", 3)
+	}
+	return
+}
+
+// SizeFramed - Child
+func (child *Child) SizeFramed() int {
+	return bframe.Size(child.Size())
+}
+
+// MarshalFramed - Child
+func (child *Child) MarshalFramed(b []byte) {
+	start := bframe.Reserve(0, b)
+	bframe.Finish(start, child.NestedMarshal(start, b, 0), b)
+}
+
+// UnmarshalFramed - Child
+func (child *Child) UnmarshalFramed(b []byte) (err error) {
+	var body []byte
+	if _, body, err = bframe.Unmarshal(0, b); err != nil {
 		return
 	}
-	if n, err = child.Parents.UnmarshalPlain(n, b); err != nil {
+	return child.Unmarshal(body)
+}
+
+// MarshalSealed - Child
+func (child *Child) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := child.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - Child
+func (child *Child) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
 		return
 	}
-	return
+	return child.Unmarshal(b)
 }
 
 // Struct - Parents
@@ -278,6 +583,11 @@ type Parents struct {
 // Reserved Ids - Parents
 var parentsRIds = []uint16{}
 
+// Interfaces - Parents
+var _ benc.Message = (*Parents)(nil)
+var _ benc.PlainMessage = (*Parents)(nil)
+var _ benc.NestedMessage = (*Parents)(nil)
+
 // Size - Parents
 func (parents *Parents) Size() int {
 	return parents.NestedSize(0)
@@ -330,14 +640,48 @@ func (parents *Parents) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - Parents
+func (parents *Parents) MarshalAppend(b []byte) []byte {
+	return parents.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - Parents
+func (parents *Parents) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 1)
+	b = bstd.AppendString(b, parents.Mother)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 2)
+	b = bstd.AppendString(b, parents.Father)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - Parents
+func (parents *Parents) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendString(b, parents.Mother)
+	b = bstd.AppendString(b, parents.Father)
+	return b
+}
+
 // Unmarshal - Parents
 func (parents *Parents) Unmarshal(b []byte) (err error) {
 	_, err = parents.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - Parents
+func (parents *Parents) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = parents.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - Parents
 func (parents *Parents) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return parents.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - Parents
+func (parents *Parents) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -349,36 +693,135 @@ func (parents *Parents) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16)
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Parents", "mother", 1)
 	}
 	if ok {
-		if n, parents.Mother, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, parents.Mother, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Parents", "mother", 1)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, parentsRIds, 2); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Parents", "father", 2)
 	}
 	if ok {
-		if n, parents.Father, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, parents.Father, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Parents", "father", 2)
 		}
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - Parents
 func (parents *Parents) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return parents.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - Parents
+func (parents *Parents) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
-	if n, parents.Mother, err = bstd.UnmarshalString(n, b); err != nil {
-		return
+	if n, parents.Mother, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Parents", "mother", 1)
+	}
+	if n, parents.Father, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Parents", "father", 2)
 	}
-	if n, parents.Father, err = bstd.UnmarshalString(n, b); err != nil {
+	return
+}
+
+// UnmarshalReuse - Parents
+func (parents *Parents) UnmarshalReuse(b []byte) (err error) {
+	_, err = parents.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - Parents
+func (parents *Parents) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	parents.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, parentsRIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Parents", "mother", 1)
+	}
+	if ok {
+		if n, parents.Mother, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Parents", "mother", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, parentsRIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Parents", "father", 2)
+	}
+	if ok {
+		if n, parents.Father, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Parents", "father", 2)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - Parents
+func (parents *Parents) Reset() {
+	parents.Mother = ""
+	parents.Father = ""
+}
+
+// UnmarshalPlainReuse - Parents
+func (parents *Parents) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, parents.Mother, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Parents", "mother", 1)
+	}
+	if n, parents.Father, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Parents", "father", 2)
+	}
 	return
 }
+
+// SizeFramed - Parents
+func (parents *Parents) SizeFramed() int {
+	return bframe.Size(parents.Size())
+}
+
+// MarshalFramed - Parents
+func (parents *Parents) MarshalFramed(b []byte) {
+	start := bframe.Reserve(0, b)
+	bframe.Finish(start, parents.NestedMarshal(start, b, 0), b)
+}
+
+// UnmarshalFramed - Parents
+func (parents *Parents) UnmarshalFramed(b []byte) (err error) {
+	var body []byte
+	if _, body, err = bframe.Unmarshal(0, b); err != nil {
+		return
+	}
+	return parents.Unmarshal(body)
+}
+
+// MarshalSealed - Parents
+func (parents *Parents) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := parents.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - Parents
+func (parents *Parents) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return parents.Unmarshal(b)
+}
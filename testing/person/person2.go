@@ -4,6 +4,8 @@
 package person
 
 import (
+	"github.com/deneonet/benc"
+	"github.com/deneonet/benc/frame"
 	"github.com/deneonet/benc/impl/gen"
 	"github.com/deneonet/benc/std"
 )
@@ -18,6 +20,11 @@ type Person2 struct {
 // Reserved Ids - Person2
 var person2RIds = []uint16{3}
 
+// Interfaces - Person2
+var _ benc.Message = (*Person2)(nil)
+var _ benc.PlainMessage = (*Person2)(nil)
+var _ benc.NestedMessage = (*Person2)(nil)
+
 // Size - Person2
 func (person2 *Person2) Size() int {
 	return person2.NestedSize(0)
@@ -74,14 +81,50 @@ func (person2 *Person2) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - Person2
+func (person2 *Person2) MarshalAppend(b []byte) []byte {
+	return person2.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - Person2
+func (person2 *Person2) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed8, 1)
+	b = bstd.AppendByte(b, person2.Age)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 2)
+	b = bstd.AppendString(b, person2.Name)
+	b = person2.Child.NestedMarshalAppend(b, 4)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - Person2
+func (person2 *Person2) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendByte(b, person2.Age)
+	b = bstd.AppendString(b, person2.Name)
+	b = person2.Child.MarshalPlainAppend(b)
+	return b
+}
+
 // Unmarshal - Person2
 func (person2 *Person2) Unmarshal(b []byte) (err error) {
 	_, err = person2.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - Person2
+func (person2 *Person2) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = person2.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - Person2
 func (person2 *Person2) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return person2.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - Person2
+func (person2 *Person2) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -93,44 +136,150 @@ func (person2 *Person2) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16)
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "age", 1)
 	}
 	if ok {
 		if n, person2.Age, err = bstd.UnmarshalByte(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "Person2", "age", 1)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, person2RIds, 2); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "name", 2)
 	}
 	if ok {
-		if n, person2.Name, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, person2.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Person2", "name", 2)
 		}
 	}
-	if n, err = person2.Child.NestedUnmarshal(n, b, person2RIds, 4); err != nil {
-		return
+	if n, err = person2.Child.NestedUnmarshalLimited(n, b, person2RIds, 4, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "child", 4)
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - Person2
 func (person2 *Person2) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return person2.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - Person2
+func (person2 *Person2) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
 	if n, person2.Age, err = bstd.UnmarshalByte(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "age", 1)
+	}
+	if n, person2.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "name", 2)
+	}
+	if n, err = person2.Child.UnmarshalPlainLimited(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "child", 4)
+	}
+	return
+}
+
+// UnmarshalReuse - Person2
+func (person2 *Person2) UnmarshalReuse(b []byte) (err error) {
+	_, err = person2.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - Person2
+func (person2 *Person2) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	person2.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
-	if n, person2.Name, err = bstd.UnmarshalString(n, b); err != nil {
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, person2RIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "age", 1)
+	}
+	if ok {
+		if n, person2.Age, err = bstd.UnmarshalByte(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Person2", "age", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, person2RIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "name", 2)
+	}
+	if ok {
+		if n, person2.Name, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Person2", "name", 2)
+		}
+	}
+	if n, err = person2.Child.NestedUnmarshalReuse(n, b, person2RIds, 4, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "child", 4)
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - Person2
+func (person2 *Person2) Reset() {
+	person2.Age = 0
+	person2.Name = ""
+	person2.Child.Reset()
+}
+
+// UnmarshalPlainReuse - Person2
+func (person2 *Person2) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, person2.Age, err = bstd.UnmarshalByte(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "age", 1)
+	}
+	if n, person2.Name, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "name", 2)
+	}
+	if n, err = person2.Child.UnmarshalPlainReuse(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Person2", "child", 4)
+	}
+	return
+}
+
+// SizeFramed - Person2
+func (person2 *Person2) SizeFramed() int {
+	return bframe.Size(person2.Size())
+}
+
+// MarshalFramed - Person2
+func (person2 *Person2) MarshalFramed(b []byte) {
+	start := bframe.Reserve(0, b)
+	bframe.Finish(start, person2.NestedMarshal(start, b, 0), b)
+}
+
+// UnmarshalFramed - Person2
+func (person2 *Person2) UnmarshalFramed(b []byte) (err error) {
+	var body []byte
+	if _, body, err = bframe.Unmarshal(0, b); err != nil {
 		return
 	}
-	if n, err = person2.Child.UnmarshalPlain(n, b); err != nil {
+	return person2.Unmarshal(body)
+}
+
+// MarshalSealed - Person2
+func (person2 *Person2) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := person2.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - Person2
+func (person2 *Person2) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
 		return
 	}
-	return
+	return person2.Unmarshal(b)
 }
 
 // Struct - Child2
@@ -142,6 +291,11 @@ type Child2 struct {
 // Reserved Ids - Child2
 var child2RIds = []uint16{2}
 
+// Interfaces - Child2
+var _ benc.Message = (*Child2)(nil)
+var _ benc.PlainMessage = (*Child2)(nil)
+var _ benc.NestedMessage = (*Child2)(nil)
+
 // Size - Child2
 func (child2 *Child2) Size() int {
 	return child2.NestedSize(0)
@@ -193,14 +347,47 @@ func (child2 *Child2) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - Child2
+func (child2 *Child2) MarshalAppend(b []byte) []byte {
+	return child2.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - Child2
+func (child2 *Child2) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Fixed8, 1)
+	b = bstd.AppendByte(b, child2.Age)
+	b = child2.Parents.NestedMarshalAppend(b, 3)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - Child2
+func (child2 *Child2) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendByte(b, child2.Age)
+	b = child2.Parents.MarshalPlainAppend(b)
+	return b
+}
+
 // Unmarshal - Child2
 func (child2 *Child2) Unmarshal(b []byte) (err error) {
 	_, err = child2.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - Child2
+func (child2 *Child2) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = child2.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - Child2
 func (child2 *Child2) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return child2.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - Child2
+func (child2 *Child2) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -212,32 +399,123 @@ func (child2 *Child2) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Child2", "age", 1)
 	}
 	if ok {
 		if n, child2.Age, err = bstd.UnmarshalByte(n, b); err != nil {
-			return
+			return 0, bgenimpl.WrapFieldError(err, "Child2", "age", 1)
 		}
 	}
-	if n, err = child2.Parents.NestedUnmarshal(n, b, child2RIds, 3); err != nil {
-		return
+	if n, err = child2.Parents.NestedUnmarshalLimited(n, b, child2RIds, 3, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child2", "parents", 3)
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - Child2
 func (child2 *Child2) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return child2.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - Child2
+func (child2 *Child2) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
 	if n, child2.Age, err = bstd.UnmarshalByte(n, b); err != nil {
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Child2", "age", 1)
+	}
+	if n, err = child2.Parents.UnmarshalPlainLimited(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child2", "parents", 3)
 	}
-	if n, err = child2.Parents.UnmarshalPlain(n, b); err != nil {
+	return
+}
+
+// UnmarshalReuse - Child2
+func (child2 *Child2) UnmarshalReuse(b []byte) (err error) {
+	_, err = child2.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - Child2
+func (child2 *Child2) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	child2.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, child2RIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Child2", "age", 1)
+	}
+	if ok {
+		if n, child2.Age, err = bstd.UnmarshalByte(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Child2", "age", 1)
+		}
+	}
+	if n, err = child2.Parents.NestedUnmarshalReuse(n, b, child2RIds, 3, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child2", "parents", 3)
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - Child2
+func (child2 *Child2) Reset() {
+	child2.Age = 0
+	child2.Parents.Reset()
+}
+
+// UnmarshalPlainReuse - Child2
+func (child2 *Child2) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, child2.Age, err = bstd.UnmarshalByte(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child2", "age", 1)
+	}
+	if n, err = child2.Parents.UnmarshalPlainReuse(n, b, l); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Child2", "parents", 3)
+	}
 	return
 }
 
+// SizeFramed - Child2
+func (child2 *Child2) SizeFramed() int {
+	return bframe.Size(child2.Size())
+}
+
+// MarshalFramed - Child2
+func (child2 *Child2) MarshalFramed(b []byte) {
+	start := bframe.Reserve(0, b)
+	bframe.Finish(start, child2.NestedMarshal(start, b, 0), b)
+}
+
+// UnmarshalFramed - Child2
+func (child2 *Child2) UnmarshalFramed(b []byte) (err error) {
+	var body []byte
+	if _, body, err = bframe.Unmarshal(0, b); err != nil {
+		return
+	}
+	return child2.Unmarshal(body)
+}
+
+// MarshalSealed - Child2
+func (child2 *Child2) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := child2.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - Child2
+func (child2 *Child2) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return child2.Unmarshal(b)
+}
+
 // Struct - Parents2
 type Parents2 struct {
 	Mother string
@@ -247,6 +525,11 @@ type Parents2 struct {
 // Reserved Ids - Parents2
 var parents2RIds = []uint16{}
 
+// Interfaces - Parents2
+var _ benc.Message = (*Parents2)(nil)
+var _ benc.PlainMessage = (*Parents2)(nil)
+var _ benc.NestedMessage = (*Parents2)(nil)
+
 // Size - Parents2
 func (parents2 *Parents2) Size() int {
 	return parents2.NestedSize(0)
@@ -299,14 +582,48 @@ func (parents2 *Parents2) MarshalPlain(tn int, b []byte) (n int) {
 	return n
 }
 
+// MarshalAppend - Parents2
+func (parents2 *Parents2) MarshalAppend(b []byte) []byte {
+	return parents2.NestedMarshalAppend(b, 0)
+}
+
+// Nested MarshalAppend - Parents2
+func (parents2 *Parents2) NestedMarshalAppend(b []byte, id uint16) []byte {
+	b = bgenimpl.AppendTag(b, bgenimpl.Container, id)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 1)
+	b = bstd.AppendString(b, parents2.Mother)
+	b = bgenimpl.AppendTag(b, bgenimpl.Bytes, 2)
+	b = bstd.AppendString(b, parents2.Father)
+
+	return append(b, 1, 1)
+}
+
+// MarshalPlainAppend - Parents2
+func (parents2 *Parents2) MarshalPlainAppend(b []byte) []byte {
+	b = bstd.AppendString(b, parents2.Mother)
+	b = bstd.AppendString(b, parents2.Father)
+	return b
+}
+
 // Unmarshal - Parents2
 func (parents2 *Parents2) Unmarshal(b []byte) (err error) {
 	_, err = parents2.NestedUnmarshal(0, b, []uint16{}, 0)
 	return
 }
 
+// UnmarshalLimited - Parents2
+func (parents2 *Parents2) UnmarshalLimited(b []byte, l bstd.DecodeLimits) (err error) {
+	_, err = parents2.NestedUnmarshalLimited(0, b, []uint16{}, 0, &l)
+	return
+}
+
 // Nested Unmarshal - Parents2
 func (parents2 *Parents2) NestedUnmarshal(tn int, b []byte, r []uint16, id uint16) (n int, err error) {
+	return parents2.NestedUnmarshalLimited(tn, b, r, id, nil)
+}
+
+// Nested UnmarshalLimited - Parents2
+func (parents2 *Parents2) NestedUnmarshalLimited(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
 	var ok bool
 	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
 		if err == bgenimpl.ErrEof {
@@ -318,36 +635,135 @@ func (parents2 *Parents2) NestedUnmarshal(tn int, b []byte, r []uint16, id uint1
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Parents2", "mother", 1)
 	}
 	if ok {
-		if n, parents2.Mother, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, parents2.Mother, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Parents2", "mother", 1)
 		}
 	}
 	if n, ok, err = bgenimpl.HandleCompatibility(n, b, parents2RIds, 2); err != nil {
 		if err == bgenimpl.ErrEof {
 			return n, nil
 		}
-		return
+		return 0, bgenimpl.WrapFieldError(err, "Parents2", "father", 2)
 	}
 	if ok {
-		if n, parents2.Father, err = bstd.UnmarshalString(n, b); err != nil {
-			return
+		if n, parents2.Father, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Parents2", "father", 2)
 		}
 	}
-	n += 2
-	return
+	return bgenimpl.SkipContainerEnd(n, b)
 }
 
 // UnmarshalPlain - Parents2
 func (parents2 *Parents2) UnmarshalPlain(tn int, b []byte) (n int, err error) {
+	return parents2.UnmarshalPlainLimited(tn, b, nil)
+}
+
+// UnmarshalPlainLimited - Parents2
+func (parents2 *Parents2) UnmarshalPlainLimited(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
 	n = tn
-	if n, parents2.Mother, err = bstd.UnmarshalString(n, b); err != nil {
-		return
+	if n, parents2.Mother, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Parents2", "mother", 1)
 	}
-	if n, parents2.Father, err = bstd.UnmarshalString(n, b); err != nil {
+	if n, parents2.Father, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Parents2", "father", 2)
+	}
+	return
+}
+
+// UnmarshalReuse - Parents2
+func (parents2 *Parents2) UnmarshalReuse(b []byte) (err error) {
+	_, err = parents2.NestedUnmarshalReuse(0, b, []uint16{}, 0, nil)
+	return
+}
+
+// Nested UnmarshalReuse - Parents2
+func (parents2 *Parents2) NestedUnmarshalReuse(tn int, b []byte, r []uint16, id uint16, l *bstd.DecodeLimits) (n int, err error) {
+	parents2.Reset()
+	var ok bool
+	if n, ok, err = bgenimpl.HandleCompatibility(tn, b, r, id); !ok {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
 		return
 	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, parents2RIds, 1); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Parents2", "mother", 1)
+	}
+	if ok {
+		if n, parents2.Mother, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Parents2", "mother", 1)
+		}
+	}
+	if n, ok, err = bgenimpl.HandleCompatibility(n, b, parents2RIds, 2); err != nil {
+		if err == bgenimpl.ErrEof {
+			return n, nil
+		}
+		return 0, bgenimpl.WrapFieldError(err, "Parents2", "father", 2)
+	}
+	if ok {
+		if n, parents2.Father, err = l.UnmarshalString(n, b); err != nil {
+			return 0, bgenimpl.WrapFieldError(err, "Parents2", "father", 2)
+		}
+	}
+	return bgenimpl.SkipContainerEnd(n, b)
+}
+
+// Reset - Parents2
+func (parents2 *Parents2) Reset() {
+	parents2.Mother = ""
+	parents2.Father = ""
+}
+
+// UnmarshalPlainReuse - Parents2
+func (parents2 *Parents2) UnmarshalPlainReuse(tn int, b []byte, l *bstd.DecodeLimits) (n int, err error) {
+	n = tn
+	if n, parents2.Mother, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Parents2", "mother", 1)
+	}
+	if n, parents2.Father, err = l.UnmarshalString(n, b); err != nil {
+		return 0, bgenimpl.WrapFieldError(err, "Parents2", "father", 2)
+	}
 	return
 }
+
+// SizeFramed - Parents2
+func (parents2 *Parents2) SizeFramed() int {
+	return bframe.Size(parents2.Size())
+}
+
+// MarshalFramed - Parents2
+func (parents2 *Parents2) MarshalFramed(b []byte) {
+	start := bframe.Reserve(0, b)
+	bframe.Finish(start, parents2.NestedMarshal(start, b, 0), b)
+}
+
+// UnmarshalFramed - Parents2
+func (parents2 *Parents2) UnmarshalFramed(b []byte) (err error) {
+	var body []byte
+	if _, body, err = bframe.Unmarshal(0, b); err != nil {
+		return
+	}
+	return parents2.Unmarshal(body)
+}
+
+// MarshalSealed - Parents2
+func (parents2 *Parents2) MarshalSealed(s benc.Sealer, b []byte) ([]byte, error) {
+	p := parents2.MarshalAppend(nil)
+	b, err := s.Seal(b, p)
+	clear(p)
+	return b, err
+}
+
+// UnmarshalSealed - Parents2
+func (parents2 *Parents2) UnmarshalSealed(s benc.Sealer, b []byte) (err error) {
+	if b, err = s.Open(nil, b); err != nil {
+		return
+	}
+	return parents2.Unmarshal(b)
+}
@@ -1,8 +1,16 @@
-//go:generate bencgen --in ../schemas/person.benc,../schemas/person2.benc --out ./ --file ... --lang go
+//go:generate bencgen --in ../schemas/person.benc,../schemas/person2.benc --out ./ --file ... --lang go --framed
 
 package person
 
-import "testing"
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/deneonet/benc"
+	"github.com/deneonet/benc/frame"
+	"github.com/deneonet/benc/seal"
+)
 
 // Forward compatibility
 func TestPersonToPerson2(t *testing.T) {
@@ -112,3 +120,107 @@ func TestPerson2ToPerson(t *testing.T) {
 		t.Errorf("Expected Child's Father %s, got %s", expectedPerson.Child.Parents.Father, deserPerson.Child.Parents.Father)
 	}
 }
+
+func TestPersonFramed(t *testing.T) {
+	originalPerson := Person{
+		Age:  30,
+		Name: "John Doe",
+		Child: Child{
+			Age:  10,
+			Name: "Junior Doe",
+		},
+	}
+
+	buf := make([]byte, originalPerson.SizeFramed())
+	originalPerson.MarshalFramed(buf)
+
+	var deserPerson Person
+	if err := deserPerson.UnmarshalFramed(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deserPerson, originalPerson) {
+		t.Errorf("Deserialized- and original person don't match!")
+	}
+
+	// A flipped bit in the name
+	buf[len(buf)/2] ^= 0x04
+	if err := deserPerson.UnmarshalFramed(buf); !errors.Is(err, bframe.ErrChecksumMismatch) {
+		t.Fatalf("expected bframe.ErrChecksumMismatch, got %v", err)
+	}
+}
+
+func TestPersonSealed(t *testing.T) {
+	originalPerson := Person{
+		Age:  30,
+		Name: "John Doe",
+	}
+
+	k := bseal.NewKeyring()
+	if err := k.Add(1, bseal.ChaCha20Poly1305, make([]byte, 32)); err != nil {
+		t.Fatal(err)
+	}
+	k.SetPrimary(1)
+
+	buf, err := originalPerson.MarshalSealed(k, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var deserPerson Person
+	if err := deserPerson.UnmarshalSealed(k, buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deserPerson, originalPerson) {
+		t.Errorf("Deserialized- and original person don't match!")
+	}
+
+	buf[len(buf)-1] ^= 1
+	if err := deserPerson.UnmarshalSealed(k, buf); !errors.Is(err, bseal.ErrOpen) {
+		t.Fatalf("expected bseal.ErrOpen, got %v", err)
+	}
+}
+
+// Generic code, like a transport, only needs the benc interfaces
+func marshalAll[T benc.Message](bp *benc.BufPool, msgs ...T) ([][]byte, error) {
+	var bufs [][]byte
+	for _, m := range msgs {
+		l, err := benc.MarshalWithPool(bp, m)
+		if err != nil {
+			return nil, err
+		}
+		bufs = append(bufs, append([]byte(nil), l.Bytes()...))
+		l.Release()
+	}
+	return bufs, nil
+}
+
+func TestPersonMessage(t *testing.T) {
+	originalPerson := Person{
+		Age:  30,
+		Name: "John Doe",
+		Child: Child{
+			Age:  10,
+			Name: "Junior Doe",
+		},
+	}
+
+	buf := benc.MarshalToBytes(&originalPerson)
+	bufs, err := marshalAll(benc.NewBufPool(), &originalPerson, &originalPerson)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, b := range append(bufs, buf) {
+		var deserPerson Person
+		if err := deserPerson.Unmarshal(b); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(deserPerson, originalPerson) {
+			t.Errorf("Deserialized- and original person don't match!")
+		}
+	}
+
+	if _, err := marshalAll(benc.NewBufPool(benc.WithBufferSize(4)), &originalPerson); !errors.Is(err, benc.ErrReuseBufTooSmall) {
+		t.Fatalf("expected benc.ErrReuseBufTooSmall, got %v", err)
+	}
+}